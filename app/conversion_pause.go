@@ -0,0 +1,53 @@
+package app
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	cronostypes "github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+)
+
+// CronosParamsKeeper defines the cronos keeper methods used by ConversionPauseDecorator
+type CronosParamsKeeper interface {
+	GetParams(ctx sdk.Context) cronostypes.Params
+}
+
+// ConversionPauseDecorator rejects the txs converting or transferring tokens while the conversions are paused,
+// so they don't enter the mempool, the other messages are passed through.
+type ConversionPauseDecorator struct {
+	cronosKeeper CronosParamsKeeper
+}
+
+func NewConversionPauseDecorator(cronosKeeper CronosParamsKeeper) ConversionPauseDecorator {
+	return ConversionPauseDecorator{
+		cronosKeeper: cronosKeeper,
+	}
+}
+
+func (cpd ConversionPauseDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if hasConversionMsg(tx.GetMsgs()) && cpd.cronosKeeper.GetParams(ctx).ConversionPaused {
+		return ctx, errors.Wrap(cronostypes.ErrConversionPaused, "tx contains a conversion message")
+	}
+	return next(ctx, tx, simulate)
+}
+
+// hasConversionMsg checks whether the messages, including the ones executed through authz, convert or transfer tokens
+func hasConversionMsg(msgs []sdk.Msg) bool {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *cronostypes.MsgConvertVouchers, *cronostypes.MsgConvertCoin, *cronostypes.MsgTransferTokens,
+			*cronostypes.MsgConvertAndTransfer:
+			return true
+		case *authz.MsgExec:
+			innerMsgs, err := msg.GetMessages()
+			if err != nil {
+				// the message is rejected later
+				continue
+			}
+			if hasConversionMsg(innerMsgs) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package app
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	cronostypes "github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConversionPauseDecorator(t *testing.T) {
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	app := Setup(t, sender.String())
+	coins := sdk.NewCoins(sdk.NewCoin("ibc/6B5A664BF0AF4F71B2F0BAA33141E2F1321242FBD5D19762F541EC971ACB0865", sdkmath.NewInt(1)))
+	bankSend := banktypes.NewMsgSend(sender, sender, coins)
+	execConvert := authz.NewMsgExec(sender, []sdk.Msg{cronostypes.NewMsgConvertVouchers(sender.String(), coins)})
+
+	testCases := []struct {
+		name   string
+		msgs   []sdk.Msg
+		paused bool
+		expErr bool
+	}{
+		{"bank send, paused", []sdk.Msg{bankSend}, true, false},
+		{"convert vouchers, not paused", []sdk.Msg{cronostypes.NewMsgConvertVouchers(sender.String(), coins)}, false, false},
+		{"convert vouchers, paused", []sdk.Msg{cronostypes.NewMsgConvertVouchers(sender.String(), coins)}, true, true},
+		{"convert coin, paused", []sdk.Msg{cronostypes.NewMsgConvertCoin(sender.String(), coins[0])}, true, true},
+		{"transfer tokens, paused", []sdk.Msg{cronostypes.NewMsgTransferTokens(sender.String(), "0x0000000000000000000000000000000000000001", coins)}, true, true},
+		{"convert and transfer, paused", []sdk.Msg{cronostypes.NewMsgConvertAndTransfer(sender.String(), coins, "to", "channel-0")}, true, true},
+		{"bank send with a conversion, paused", []sdk.Msg{bankSend, cronostypes.NewMsgConvertCoin(sender.String(), coins[0])}, true, true},
+		{"conversion executed through authz, paused", []sdk.Msg{&execConvert}, true, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, checkTx := range []bool{true, false} {
+				ctx := app.NewContext(checkTx)
+				params := cronostypes.DefaultParams()
+				params.ConversionPaused = tc.paused
+				require.NoError(t, app.CronosKeeper.SetParams(ctx, params))
+
+				txBuilder := app.TxConfig().NewTxBuilder()
+				require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+
+				nextCalled := false
+				next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+					nextCalled = true
+					return ctx, nil
+				}
+				_, err := NewConversionPauseDecorator(app.CronosKeeper).AnteHandle(ctx, txBuilder.GetTx(), false, next)
+				if tc.expErr {
+					require.ErrorIs(t, err, cronostypes.ErrConversionPaused)
+					require.False(t, nextCalled)
+				} else {
+					require.NoError(t, err)
+					require.True(t, nextCalled)
+				}
+			}
+		})
+	}
+}
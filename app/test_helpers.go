@@ -118,7 +118,9 @@ func SetupWithGenesisValSet(t *testing.T, cronosAdmin string, valSet *tmtypes.Va
 	require.NoError(t, err)
 
 	cronosGen := cronostypes.DefaultGenesis()
-	cronosGen.Params.CronosAdmin = cronosAdmin
+	if len(cronosAdmin) > 0 {
+		cronosGen.Params.CronosAdmins = []string{cronosAdmin}
+	}
 	// enable auto deployment in test genesis
 	cronosGen.Params.EnableAutoDeployment = true
 	genesisState["cronos"] = app.cdc.MustMarshalJSON(cronosGen)
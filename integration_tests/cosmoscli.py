@@ -1259,7 +1259,7 @@ class CosmosCLI:
             )
         )
 
-    def transfer_tokens(self, from_, to, amount, **kwargs):
+    def transfer_tokens(self, from_, to, amount, channel="channel-0", **kwargs):
         default_kwargs = {
             "gas": "auto",
             "gas_adjustment": "1.5",
@@ -1269,10 +1269,11 @@ class CosmosCLI:
                 "tx",
                 "cronos",
                 "transfer-tokens",
-                from_,
+                channel,
                 to,
                 amount,
                 "-y",
+                from_=from_,
                 home=self.data_dir,
                 stderr=subprocess.DEVNULL,
                 **(default_kwargs | kwargs),
@@ -59,7 +59,7 @@ def test_gov_update_params(cronos, tmp_path):
     # governance module account as signer
     signer = "crc10d07y265gmmuvt4z0w9aw880jnsr700jdufnyd"
     params = {
-        "cronos_admin": "crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp",
+        "cronos_admins": ["crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp"],
         "enable_auto_deployment": False,
         "ibc_cro_denom": "ibc/6411AE2ADA1E73DB59DB151"
         "A8988F9B7D5E7E233D8414DB6817F8F1A01600000",
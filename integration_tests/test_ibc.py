@@ -81,7 +81,8 @@ def test_cronos_transfer_tokens_acknowledgement_error(ibc):
     depends on `test_ibc` to send the original coins.
     """
     assert_ready(ibc)
-    dst_addr = "invalid_address"
+    # a valid bech32 address rejected by crypto-org-chain for its prefix
+    dst_addr = ibc.cronos.cosmos_cli().address("signer1")
     dst_amount = 2
     cli = ibc.cronos.cosmos_cli()
     src_amount = dst_amount * RATIO  # the decimal places difference
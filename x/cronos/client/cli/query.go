@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 )
@@ -27,8 +29,16 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(
 		GetContractByDenomCmd(),
 		GetDenomByContractCmd(),
+		GetTokenMappingsCmd(),
 		QueryParamsCmd(),
 		GetPermissions(),
+		GetSimulateConversionCmd(),
+		GetEscrowBalancesCmd(),
+		GetDenomDeployInfoCmd(),
+		GetContractsByDenomsCmd(),
+		GetCRC20BalanceCmd(),
+		GetCRC20SupplyCmd(),
+		GetConversionHistoryCmd(),
 	)
 
 	// this line is used by starport scaffolding # 1
@@ -72,8 +82,15 @@ func GetContractByDenomCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "contract-by-denom [denom]",
 		Short: "Gets contract addresses connected with the coin denom",
-		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(`Gets the external and auto-deployed contract addresses connected with the coin denom:
+
+$ <appd> query cronos contract-by-denom ibc/6B5A664BF0AF4F71B2F0BAA33141E2F1321242FBD5D19762F541EC971ACB0865 --output json
+`),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if !types.IsValidCoinDenom(args[0]) {
+				return fmt.Errorf("invalid denom format: %s", args[0])
+			}
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
@@ -103,8 +120,15 @@ func GetDenomByContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "denom-by-contract [contract]",
 		Short: "Gets the denom of the coin connected with the contract",
-		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(`Gets the denom of the coin connected with the contract:
+
+$ <appd> query cronos denom-by-contract 0x0000000000000000000000000000000000000000 --output json
+`),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address: %s", args[0])
+			}
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
@@ -129,6 +153,43 @@ func GetDenomByContractCmd() *cobra.Command {
 	return cmd
 }
 
+// GetTokenMappingsCmd queries all the token mappings
+func GetTokenMappingsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-mappings",
+		Short: "Gets all the token mappings, ordered by denom",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTokenMappingsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.TokenMappings(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "token-mappings")
+	return cmd
+}
+
 // GetPermissions queries the permission for a specific address
 func GetPermissions() *cobra.Command {
 	cmd := &cobra.Command{
@@ -159,3 +220,252 @@ func GetPermissions() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetSimulateConversionCmd previews the conversion of a native coin
+func GetSimulateConversionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-conversion [amount]",
+		Short: "Previews the contract and the amount of tokens received by converting a native coin",
+		Long: strings.TrimSpace(`Previews the contract and the amount of tokens received by converting a native coin with convert-vouchers:
+
+$ <appd> query cronos simulate-conversion 100ibc/6B5A664BF0AF4F71B2F0BAA33141E2F1321242FBD5D19762F541EC971ACB0865 --output json
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySimulateConversionRequest{
+				Denom:  coin.Denom,
+				Amount: coin.Amount.String(),
+			}
+
+			res, err := queryClient.SimulateConversion(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetEscrowBalancesCmd queries the coins escrowed for the mapped denoms
+func GetEscrowBalancesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "escrow-balances",
+		Short: "Gets the coins escrowed to back the crc20 tokens of the mapped denoms, ordered by denom",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEscrowBalancesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.EscrowBalances(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "escrow-balances")
+	return cmd
+}
+
+// GetDenomDeployInfoCmd queries the contract mapped to a denom and whether it would be auto-deployed
+func GetDenomDeployInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-deploy-info [denom]",
+		Short: "Gets the contract mapped to a denom, whether it was auto-deployed and whether the auto-deployment is enabled",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomDeployInfoRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.DenomDeployInfo(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetContractsByDenomsCmd queries the contracts of several denoms at once
+func GetContractsByDenomsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contracts-by-denoms [denom]...",
+		Short: "Gets the contracts mapped to the coin denoms, in the order of the arguments",
+		Long: strings.TrimSpace(`Gets the contracts mapped to the coin denoms, the external contract is taken in preference to the auto-deployed one:
+
+$ <appd> query cronos contracts-by-denoms ibc/6B5A664BF0AF4F71B2F0BAA33141E2F1321242FBD5D19762F541EC971ACB0865 gravity0x0000000000000000000000000000000000000000 --output json
+`),
+		Args: cobra.RangeArgs(1, types.MaxContractsByDenomsQuery),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryContractsByDenomsRequest{
+				Denoms: args,
+			}
+
+			res, err := queryClient.ContractsByDenoms(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCRC20BalanceCmd queries the crc20 balance of an evm address
+func GetCRC20BalanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "crc20-balance [denom] [evm-address]",
+		Short: "Gets the balance of the evm address in the crc20 contract mapped to the denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !common.IsHexAddress(args[1]) {
+				return fmt.Errorf("invalid evm address: %s", args[1])
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCRC20BalanceRequest{
+				Denom:   args[0],
+				Address: args[1],
+			}
+
+			res, err := queryClient.CRC20Balance(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCRC20SupplyCmd queries the total supply of the crc20 contract mapped to a denom
+func GetCRC20SupplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "crc20-supply [denom]",
+		Short: "Gets the total supply of the crc20 contract mapped to the denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCRC20SupplyRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.CRC20Supply(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetConversionHistoryCmd queries the recent conversions of an evm address
+func GetConversionHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conversion-history [evm-address]",
+		Short: "Gets the recent conversions of the evm address, the latest first, if the conversion history is enabled",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid evm address: %s", args[0])
+			}
+			limit, err := cmd.Flags().GetUint32(flags.FlagLimit)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConversionHistoryRequest{
+				Address: args[0],
+				Limit:   limit,
+			}
+
+			res, err := queryClient.ConversionHistory(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(flags.FlagLimit, 0, "maximum number of conversions returned, all the recorded ones if zero")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/version"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -22,6 +23,8 @@ import (
 	icagenesistypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/genesis/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 	icaauthtypes "github.com/crypto-org-chain/cronos/v2/x/icaauth/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
@@ -41,7 +44,9 @@ func GetTxCmd() *cobra.Command {
 	// this line is used by starport scaffolding # 1
 
 	cmd.AddCommand(CmdConvertTokens())
-	cmd.AddCommand(CmdSendToCryptoOrg())
+	cmd.AddCommand(CmdConvertCoin())
+	cmd.AddCommand(CmdTransferTokens())
+	cmd.AddCommand(CmdConvertAndTransfer())
 	cmd.AddCommand(CmdUpdateTokenMapping())
 	cmd.AddCommand(CmdTurnBridge())
 	cmd.AddCommand(CmdUpdatePermissions())
@@ -49,6 +54,9 @@ func GetTxCmd() *cobra.Command {
 	return cmd
 }
 
+// FlagRecipient is the flag of the convert-vouchers recipient
+const FlagRecipient = "recipient"
+
 func CmdConvertTokens() *cobra.Command {
 	cmd := &cobra.Command{
 		Use: "convert-vouchers [address] [amount]",
@@ -70,7 +78,43 @@ func CmdConvertTokens() *cobra.Command {
 				return err
 			}
 
+			recipient, err := cmd.Flags().GetString(FlagRecipient)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgConvertVouchers(clientCtx.GetFromAddress().String(), coins)
+			msg.Recipient = recipient
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagRecipient, "", "The evm address receiving the tokens, defaults to the evm address of the sender")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConvertCoin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-coin [amount]",
+		Short: "Convert a native coin to the crc20 tokens of its registered contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgConvertCoin(clientCtx.GetFromAddress().String(), coin)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -83,29 +127,134 @@ func CmdConvertTokens() *cobra.Command {
 	return cmd
 }
 
-func CmdSendToCryptoOrg() *cobra.Command {
+// CmdTransferTokens flags
+const (
+	FlagTimeoutHeight    = "timeout-height"
+	FlagTimeoutTimestamp = "timeout-timestamp"
+	FlagMemo             = "memo"
+)
+
+func CmdTransferTokens() *cobra.Command {
 	cmd := &cobra.Command{
-		Use: "transfer-tokens [from] [to] [amount]",
-		Short: "Transfer cronos tokens to the origin chain through IBC , Note, the'--from' flag is" +
-			" ignored as it is implied from [from].`",
+		Use:   "transfer-tokens [src-channel] [receiver] [amount]",
+		Short: "Transfer cronos tokens or ibc vouchers to the receiver on the counterparty chain through IBC",
+		Long: `Transfer cronos tokens or ibc vouchers to the receiver on the counterparty chain through IBC,
+the vouchers must be sent back through the channel they were received from. The timeouts are disabled if zero,
+the module default timeout is used if both of them are.`,
+		Example: fmt.Sprintf(
+			"%s tx cronos transfer-tokens channel-0 cro1... 100basetcro --timeout-height 1-1000 --memo memo --from mykey",
+			version.AppName,
+		),
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			err := cmd.Flags().Set(flags.FlagFrom, args[0])
+			channelID := args[0]
+			if !channeltypes.IsValidChannelID(channelID) {
+				return fmt.Errorf("invalid channel id: %s", channelID)
+			}
+			receiver := args[1]
+			if _, _, err := bech32.DecodeAndConvert(receiver); err != nil {
+				return fmt.Errorf("invalid receiver %s: %w", receiver, err)
+			}
+			coins, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			timeoutHeightStr, err := cmd.Flags().GetString(FlagTimeoutHeight)
+			if err != nil {
+				return err
+			}
+			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
 			if err != nil {
 				return err
 			}
-			argsTo := args[1]
+			timeoutTimestamp, err := cmd.Flags().GetUint64(FlagTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			memo, err := cmd.Flags().GetString(FlagMemo)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferTokens(clientCtx.GetFromAddress().String(), receiver, coins)
+			msg.ChannelId = channelID
+			msg.TimeoutHeight = timeoutHeight
+			msg.TimeoutTimestamp = timeoutTimestamp
+			msg.Memo = memo
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagTimeoutHeight, "0-0", "The timeout height on the destination chain in the format {revision}-{height}")
+	cmd.Flags().Uint64(FlagTimeoutTimestamp, 0, "The absolute timeout timestamp in nanoseconds since the unix epoch")
+	cmd.Flags().String(FlagMemo, "", "The memo of the ibc transfers")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConvertAndTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-and-transfer [src-channel] [receiver] [amount]",
+		Short: "Convert ibc vouchers to cronos tokens and transfer them to the receiver on the counterparty chain through IBC",
+		Long: `Convert ibc vouchers to cronos tokens and transfer them to the receiver on the counterparty chain through IBC
+in a single message, nothing is converted if the transfer fails. The tokens are transferred net of the conversion
+fees, the vouchers must be sent back through the channel they were received from. The timeouts are disabled if zero,
+the module default timeout is used if both of them are.`,
+		Example: fmt.Sprintf(
+			"%s tx cronos convert-and-transfer channel-0 cro1... 100ibc/... --timeout-height 1-1000 --memo memo --from mykey",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			channelID := args[0]
+			if !channeltypes.IsValidChannelID(channelID) {
+				return fmt.Errorf("invalid channel id: %s", channelID)
+			}
+			receiver := args[1]
+			if _, _, err := bech32.DecodeAndConvert(receiver); err != nil {
+				return fmt.Errorf("invalid receiver %s: %w", receiver, err)
+			}
 			coins, err := sdk.ParseCoinsNormalized(args[2])
 			if err != nil {
 				return err
 			}
 
+			timeoutHeightStr, err := cmd.Flags().GetString(FlagTimeoutHeight)
+			if err != nil {
+				return err
+			}
+			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			if err != nil {
+				return err
+			}
+			timeoutTimestamp, err := cmd.Flags().GetUint64(FlagTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			memo, err := cmd.Flags().GetString(FlagMemo)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgTransferTokens(clientCtx.GetFromAddress().String(), argsTo, coins)
+			msg := types.NewMsgConvertAndTransfer(clientCtx.GetFromAddress().String(), coins, receiver, channelID)
+			msg.TimeoutHeight = timeoutHeight
+			msg.TimeoutTimestamp = timeoutTimestamp
+			msg.Memo = memo
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -113,6 +262,9 @@ func CmdSendToCryptoOrg() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagTimeoutHeight, "0-0", "The timeout height on the destination chain in the format {revision}-{height}")
+	cmd.Flags().Uint64(FlagTimeoutTimestamp, 0, "The absolute timeout timestamp in nanoseconds since the unix epoch")
+	cmd.Flags().String(FlagMemo, "", "The memo of the ibc transfers")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -219,7 +371,7 @@ $ %s tx gov submit-legacy-proposal token-mapping-change gravity0x0000...0000 0x0
 func CmdUpdateTokenMapping() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-token-mapping [denom] [contract]",
-		Short: "Update token mapping",
+		Short: "Update token mapping, an empty contract deletes the mapping of the denom",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -17,14 +17,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	}
 
 	for _, m := range genState.ExternalContracts {
-		// Only allowed to bootstrap external token at genesis
-		if !types.IsValidIBCDenom(m.Denom) && !types.IsValidGravityDenom(m.Denom) {
+		// cronos originated tokens are always mapped to external contracts
+		if !types.IsValidCoinDenom(m.Denom) {
 			panic(fmt.Sprintf("Invalid denom to map to contract: %s", m.Denom))
 		}
 		if !common.IsHexAddress(m.Contract) {
 			panic(fmt.Sprintf("Invalid contract address: %s", m.Contract))
 		}
-		if err := k.SetExternalContractForDenom(ctx, m.Denom, common.HexToAddress(m.Contract)); err != nil {
+		if err := k.SetExternalContractMapping(ctx, m.Denom, common.HexToAddress(m.Contract)); err != nil {
 			panic(err)
 		}
 	}
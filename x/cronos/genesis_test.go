@@ -1,8 +1,11 @@
 package cronos_test
 
 import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 func (suite *CronosTestSuite) TestInitGenesis() {
@@ -132,7 +135,85 @@ func (suite *CronosTestSuite) TestInitGenesis() {
 	}
 }
 
+func (suite *CronosTestSuite) TestInitGenesisReverseIndex() {
+	external := types.TokenMapping{
+		Denom:    "ibc/6B5A664BF0AF4F71B2F0BAA33141E2F1321242FBD5D19762F541EC971ACB0865",
+		Contract: "0x0000000000000000000000000000000000000001",
+	}
+	auto := types.TokenMapping{
+		Denom:    "gravity0x0000000000000000000000000000000000000000",
+		Contract: "0x0000000000000000000000000000000000000002",
+	}
+	cronos.InitGenesis(suite.ctx, suite.app.CronosKeeper, types.GenesisState{
+		Params:            types.DefaultParams(),
+		ExternalContracts: []types.TokenMapping{external},
+		AutoContracts:     []types.TokenMapping{auto},
+	})
+
+	for _, m := range []types.TokenMapping{external, auto} {
+		denom, found := suite.app.CronosKeeper.GetDenomByContract(suite.ctx, common.HexToAddress(m.Contract))
+		suite.Require().True(found)
+		suite.Require().Equal(m.Denom, denom)
+
+		rsp, err := suite.app.CronosKeeper.DenomByContract(suite.ctx, &types.DenomByContractRequest{Contract: m.Contract})
+		suite.Require().NoError(err)
+		suite.Require().Equal(m.Denom, rsp.Denom)
+	}
+
+	_, err := suite.app.CronosKeeper.DenomByContract(suite.ctx, &types.DenomByContractRequest{Contract: "0x01"})
+	suite.Require().Error(err)
+}
+
 func (suite *CronosTestSuite) TestExportGenesis() {
 	genesisState := cronos.ExportGenesis(suite.ctx, suite.app.CronosKeeper)
 	suite.Require().Equal(genesisState.Params.IbcCroDenom, types.DefaultParams().IbcCroDenom)
 }
+
+func (suite *CronosTestSuite) TestExportImportGenesis() {
+	keeper := suite.app.CronosKeeper
+	address := common.BytesToAddress(suite.address.Bytes())
+
+	// cronos originated token mapped to an external contract
+	contract, err := keeper.DeployModuleCRC21(suite.ctx, "Test")
+	suite.Require().NoError(err)
+	suite.Require().NoError(keeper.RegisterOrUpdateTokenMapping(suite.ctx, &types.MsgUpdateTokenMapping{
+		Sender:   suite.address.String(),
+		Denom:    "cronos" + contract.Hex(),
+		Contract: contract.Hex(),
+		Symbol:   "Test",
+	}))
+	// external and auto-deployed contracts of ibc tokens
+	suite.Require().NoError(keeper.SetExternalContractMapping(suite.ctx,
+		"ibc/6B5A664BF0AF4F71B2F0BAA33141E2F1321242FBD5D19762F541EC971ACB0865",
+		common.HexToAddress("0x0000000000000000000000000000000000000001")))
+	denom := "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.address, coins))
+	suite.Require().NoError(keeper.ConvertCoinsFromNativeToCRC21(suite.ctx, address, coins, true))
+
+	exported := cronos.ExportGenesis(suite.ctx, keeper)
+	suite.Require().NoError(exported.Validate())
+	suite.Require().Len(exported.ExternalContracts, 2)
+	suite.Require().Len(exported.AutoContracts, 1)
+
+	// import into a fresh chain
+	suite.SetupTest()
+	cronos.InitGenesis(suite.ctx, suite.app.CronosKeeper, *exported)
+	reexported := cronos.ExportGenesis(suite.ctx, suite.app.CronosKeeper)
+
+	cdc := suite.app.AppCodec()
+	suite.Require().Equal(string(cdc.MustMarshalJSON(exported)), string(cdc.MustMarshalJSON(reexported)))
+
+	for _, m := range append(exported.ExternalContracts, exported.AutoContracts...) {
+		found, ok := suite.app.CronosKeeper.GetDenomByContract(suite.ctx, common.HexToAddress(m.Contract))
+		suite.Require().True(ok)
+		suite.Require().Equal(m.Denom, found)
+	}
+	// the auto-deployed contracts are deployed at the same addresses on the fresh chain
+	for _, m := range exported.AutoContracts {
+		contract, err := suite.app.CronosKeeper.DeployModuleCRC21(suite.ctx, m.Denom)
+		suite.Require().NoError(err)
+		suite.Require().Equal(m.Contract, contract.Hex())
+	}
+}
@@ -125,10 +125,13 @@ func (suite *CronosTestSuite) TestUpdateTokenMapping() {
 	suite.SetupTest()
 
 	denom := "gravity0x6E7eef2b30585B2A4D45Ba9312015d5354FDB067"
-	contract := "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2"
+	// the mapped contract must have code deployed
+	deployed, err := suite.app.CronosKeeper.DeployModuleCRC21(suite.ctx, denom)
+	suite.Require().NoError(err)
+	contract := deployed.Hex()
 
 	msg := types.NewMsgUpdateTokenMapping(suite.address.String(), denom, contract, "", 0)
-	err := suite.app.CronosKeeper.RegisterOrUpdateTokenMapping(suite.ctx, msg)
+	err = suite.app.CronosKeeper.RegisterOrUpdateTokenMapping(suite.ctx, msg)
 	suite.Require().NoError(err)
 
 	contractAddr, found := suite.app.CronosKeeper.GetContractByDenom(suite.ctx, denom)
@@ -2,10 +2,15 @@ package keeper
 
 import (
 	"fmt"
+	"math"
 	"math/big"
+	"slices"
 
+	"cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -19,11 +24,34 @@ import (
 // DefaultGasCap defines the gas limit used to run internal evm call
 const DefaultGasCap uint64 = 25000000
 
+// crc21FixedSlots is the number of the fixed storage slots of the embedded crc21 contract, they hold the authority,
+// the owner, the total supply, the roots of the balances and allowances mappings, the symbol, the decimals, the name,
+// the denom and the source flag.
+const crc21FixedSlots = 10
+
+var (
+	// crc21SymbolSlot is the storage slot of the symbol of the embedded crc21 contract, which has no setter for it
+	crc21SymbolSlot = common.BigToHash(big.NewInt(5))
+	// crc21TotalSupplySlot is the only fixed storage slot of the embedded crc21 contract holding token state
+	crc21TotalSupplySlot = common.BigToHash(big.NewInt(2))
+	// crc21StringSlots are the storage slots of the symbol, the name and the denom of the embedded crc21 contract
+	crc21StringSlots = []common.Hash{
+		crc21SymbolSlot,
+		common.BigToHash(big.NewInt(7)),
+		common.BigToHash(big.NewInt(8)),
+	}
+)
+
 // CallEVM execute an evm message from native module
 func (k Keeper) CallEVM(ctx sdk.Context, to *common.Address, data []byte, value *big.Int, gasLimit uint64) (*core.Message, *evmtypes.MsgEthereumTxResponse, error) {
-	nonce := k.evmKeeper.GetNonce(ctx, types.EVMModuleAddress)
+	return k.callEVMFrom(ctx, types.EVMModuleAddress, to, data, value, gasLimit)
+}
+
+// callEVMFrom execute an evm message from an address controlled by the native module
+func (k Keeper) callEVMFrom(ctx sdk.Context, from common.Address, to *common.Address, data []byte, value *big.Int, gasLimit uint64) (*core.Message, *evmtypes.MsgEthereumTxResponse, error) {
+	nonce := k.evmKeeper.GetNonce(ctx, from)
 	msg := &core.Message{
-		From:              types.EVMModuleAddress,
+		From:              from,
 		To:                to,
 		Nonce:             nonce,
 		Value:             value, // amount
@@ -61,33 +89,241 @@ func (k Keeper) CallModuleCRC21(ctx sdk.Context, contract common.Address, method
 		return nil, err
 	}
 	if res.Failed() {
-		return nil, fmt.Errorf("call contract failed: %s, %s, %s", contract.Hex(), method, res.Ret)
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			// the error includes the revert reason
+			return nil, fmt.Errorf("call contract failed: %s, %s, %w", contract.Hex(), method, evmtypes.NewExecErrorWithReason(res.Ret))
+		}
+		return nil, fmt.Errorf("call contract failed: %s, %s, %s", contract.Hex(), method, res.VmError)
 	}
 	return res.Ret, nil
 }
 
-// DeployModuleCRC21 deploy an embed crc21 contract
+// DeployModuleCRC21 deploy an embed crc21 contract, the decimals are taken from the bank metadata of the denom,
+// it's deployed by a deployer derived from the denom, so the address only depends on it, a contract already deployed
+// for the denom is mapped again.
 func (k Keeper) DeployModuleCRC21(ctx sdk.Context, denom string) (common.Address, error) {
-	ctor, err := types.ModuleCRC21Contract.ABI.Pack("", denom, uint8(0), false)
+	return k.deployModuleCRC21(ctx, denom, crc21Salt(denom))
+}
+
+// deployModuleCRC21 deploys the embedded crc21 contract of the denom from the deployer of the salt, the ownership
+// of the contract is then handed over to the module.
+func (k Keeper) deployModuleCRC21(ctx sdk.Context, denom string, salt common.Hash) (common.Address, error) {
+	deployer := crc21Deployer(salt)
+	contract := crypto.CreateAddress(deployer, 0)
+	if acc := k.evmKeeper.GetAccount(ctx, contract); acc != nil && acc.IsContract() {
+		return contract, nil
+	}
+	if nonce := k.evmKeeper.GetNonce(ctx, deployer); nonce != 0 {
+		return common.Address{}, fmt.Errorf("deployer %s of the denom %s was already used", deployer.Hex(), denom)
+	}
+
+	initCode, err := k.crc21InitCode(ctx, denom)
 	if err != nil {
 		return common.Address{}, err
 	}
-	data := types.ModuleCRC21Contract.Bin
-	data = append(data, ctor...)
-
-	msg, res, err := k.CallEVM(ctx, nil, data, big.NewInt(0), DefaultGasCap)
+	_, res, err := k.callEVMFrom(ctx, deployer, nil, initCode, big.NewInt(0), DefaultGasCap)
 	if err != nil {
 		return common.Address{}, err
 	}
+	if res.Failed() {
+		return common.Address{}, fmt.Errorf("contract deploy failed: %s", res.VmError)
+	}
 
+	data, err := types.ModuleCRC21Contract.ABI.Pack("setOwner", types.EVMModuleAddress)
+	if err != nil {
+		return common.Address{}, err
+	}
+	_, res, err = k.callEVMFrom(ctx, deployer, &contract, data, big.NewInt(0), DefaultGasCap)
+	if err != nil {
+		return common.Address{}, err
+	}
 	if res.Failed() {
-		return common.Address{}, fmt.Errorf("contract deploy failed: %s", res.Ret)
+		return common.Address{}, fmt.Errorf("transfer the ownership of contract %s failed: %s", contract.Hex(), res.VmError)
+	}
+
+	// the bank metadata take precedence, the symbol defaults to the denom
+	var name, symbol string
+	if metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		name, symbol = types.GetCRC21NameAndSymbolFromMetadata(metadata)
+	}
+	// name the vouchers after their full trace, vouchers with an unknown trace keep the default name
+	if name == "" && types.IsValidIBCDenom(denom) {
+		if trace, err := k.GetDenomTrace(ctx, denom); err == nil {
+			name = types.GetCRC21NameFromTrace(trace)
+		}
+	}
+	if name != "" {
+		if _, err := k.CallModuleCRC21(ctx, contract, "setName", name); err != nil {
+			return common.Address{}, err
+		}
+	}
+	if symbol != "" {
+		if err := k.setCRC21Symbol(ctx, contract, symbol); err != nil {
+			return common.Address{}, err
+		}
+	}
+	return contract, nil
+}
+
+// ModuleCRC21Address returns the address of the auto-deployed crc21 contract of the denom, or the address it would
+// be deployed at if there's none.
+func (k Keeper) ModuleCRC21Address(ctx sdk.Context, denom string) common.Address {
+	if contract, found := k.getAutoContractByDenom(ctx, denom); found {
+		return contract
+	}
+	return crypto.CreateAddress(crc21Deployer(crc21Salt(denom)), 0)
+}
+
+// crc21InitCode returns the bytecode of the embedded crc21 contract followed by the constructor arguments of the denom
+func (k Keeper) crc21InitCode(ctx sdk.Context, denom string) ([]byte, error) {
+	decimals, _, err := k.GetDenomDecimals(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+	ctor, err := types.ModuleCRC21Contract.ABI.Pack("", denom, decimals, false)
+	if err != nil {
+		return nil, err
+	}
+	initCode := make([]byte, 0, len(types.ModuleCRC21Contract.Bin)+len(ctor))
+	initCode = append(initCode, types.ModuleCRC21Contract.Bin...)
+	return append(initCode, ctor...), nil
+}
+
+// crc21Salt returns the salt of the deployer of the crc21 contract of the denom, the ibc vouchers are identified by
+// the hash of their trace.
+func crc21Salt(denom string) common.Hash {
+	return crypto.Keccak256Hash([]byte(denom))
+}
+
+// crc21RedeploySalt returns the salt of the deployer of the contract replacing a broken crc21 contract of the denom,
+// the address of the broken one is included, as its deployer was already used.
+func crc21RedeploySalt(denom string, broken common.Address) common.Hash {
+	return crypto.Keccak256Hash([]byte(denom), broken.Bytes())
+}
+
+// crc21Deployer returns the address deploying the crc21 contract of the salt, it has no key so only the module can
+// send messages from it, and its first contract is deployed at an address which doesn't depend on the init code.
+func crc21Deployer(salt common.Hash) common.Address {
+	return common.BytesToAddress(crypto.Keccak256(types.EVMModuleAddress.Bytes(), salt.Bytes()))
+}
+
+// setCRC21Symbol writes the symbol of a crc21 contract to its storage with the solidity layout of the strings,
+// a short string is stored in the slot with its doubled length in the lowest byte, while the slot of a long one
+// holds its doubled length plus one, its content being stored from the keccak256 hash of the slot.
+func (k Keeper) setCRC21Symbol(ctx sdk.Context, contract common.Address, symbol string) error {
+	if len(symbol) >= common.HashLength {
+		return fmt.Errorf("symbol %s is too long", symbol)
+	}
+	// clear the content of the former symbol if it's a long one
+	current := k.evmKeeper.GetState(ctx, contract, crc21SymbolSlot).Big()
+	if current.Bit(0) == 1 {
+		length := new(big.Int).Rsh(current, 1).Uint64()
+		base := crypto.Keccak256Hash(crc21SymbolSlot.Bytes()).Big()
+		for i := uint64(0); i < (length+common.HashLength-1)/common.HashLength; i++ {
+			k.evmKeeper.SetState(ctx, contract, common.BigToHash(new(big.Int).Add(base, new(big.Int).SetUint64(i))), nil)
+		}
+	}
+	var value common.Hash
+	copy(value[:], symbol)
+	value[common.HashLength-1] = byte(len(symbol) * 2)
+	k.evmKeeper.SetState(ctx, contract, crc21SymbolSlot, value.Bytes())
+	return nil
+}
+
+// RedeployContract redeploys the auto deployed contract of the denom when there's no code left at its address, the
+// storage left by the old contract (balances, allowances and total supply) is moved to the new one, as well as the
+// escrowed coins, then the mapping is repointed to the new contract.
+func (k Keeper) RedeployContract(ctx sdk.Context, denom string) (common.Address, error) {
+	oldContract, found := k.getAutoContractByDenom(ctx, denom)
+	if !found {
+		return common.Address{}, errors.Wrapf(sdkerrors.ErrInvalidRequest, "no auto-deployed contract found for the denom %s", denom)
+	}
+	if acc := k.evmKeeper.GetAccount(ctx, oldContract); acc != nil && acc.IsContract() {
+		return common.Address{}, errors.Wrapf(types.ErrContractCodeExists, "contract %s doesn't need to be redeployed", oldContract.Hex())
+	}
+
+	newContract, err := k.deployModuleCRC21(ctx, denom, crc21RedeploySalt(denom, oldContract))
+	if err != nil {
+		return common.Address{}, err
+	}
+	if err := k.moveContractState(ctx, denom, oldContract, newContract); err != nil {
+		return common.Address{}, err
+	}
+
+	k.SetAutoContractForDenom(ctx, denom, newContract)
+
+	k.Logger(ctx).Info("contract redeployed", "denom", denom, "old", oldContract.Hex(), "new", newContract.Hex())
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRedeployContract{
+		Denom:       denom,
+		OldContract: oldContract.Hex(),
+		NewContract: newContract.Hex(),
+	}); err != nil {
+		return common.Address{}, err
+	}
+	return newContract, nil
+}
+
+// moveContractState moves the balances, the allowances and the total supply of the old contract to the new one, and
+// the coins it escrows unless the denom is a source one, the old contract is left without balances. The owner and
+// the metadata set by the deployment of the new contract are kept.
+func (k Keeper) moveContractState(ctx sdk.Context, denom string, oldContract, newContract common.Address) error {
+	metadataSlots := k.crc21MetadataSlots(ctx, oldContract)
+	// the storage can't be updated while it's iterated
+	var keys, values []common.Hash
+	k.evmKeeper.ForEachStorage(ctx, oldContract, func(key, value common.Hash) bool {
+		if !metadataSlots[key] {
+			keys = append(keys, key)
+			values = append(values, value)
+		}
+		return true
+	})
+	for i, key := range keys {
+		k.evmKeeper.SetState(ctx, newContract, key, values[i].Bytes())
+		// clear the old contract, so its tokens can't be spent anymore
+		k.evmKeeper.SetState(ctx, oldContract, key, nil)
+	}
+
+	if types.IsSourceCoin(denom) {
+		return nil
+	}
+	escrowed := k.bankKeeper.GetBalance(ctx, sdk.AccAddress(oldContract.Bytes()), denom)
+	if escrowed.IsZero() {
+		return nil
+	}
+	return k.bankKeeper.SendCoins(ctx, sdk.AccAddress(oldContract.Bytes()), sdk.AccAddress(newContract.Bytes()), sdk.NewCoins(escrowed))
+}
+
+// crc21MetadataSlots returns the storage slots of a crc21 contract which don't hold token state, that is the fixed
+// slots other than the total supply, and the content of the strings longer than 31 bytes, which is stored from the
+// keccak256 hash of their slot, the entries of the mappings are the only other slots.
+func (k Keeper) crc21MetadataSlots(ctx sdk.Context, contract common.Address) map[common.Hash]bool {
+	slots := make(map[common.Hash]bool, crc21FixedSlots)
+	for i := int64(0); i < crc21FixedSlots; i++ {
+		if slot := common.BigToHash(big.NewInt(i)); slot != crc21TotalSupplySlot {
+			slots[slot] = true
+		}
+	}
+	for _, slot := range crc21StringSlots {
+		value := k.evmKeeper.GetState(ctx, contract, slot).Big()
+		if value.Bit(0) == 0 {
+			continue
+		}
+		length := new(big.Int).Rsh(value, 1).Uint64()
+		base := crypto.Keccak256Hash(slot.Bytes()).Big()
+		for i := uint64(0); i < (length+common.HashLength-1)/common.HashLength; i++ {
+			slots[common.BigToHash(new(big.Int).Add(base, new(big.Int).SetUint64(i)))] = true
+		}
 	}
-	return crypto.CreateAddress(types.EVMModuleAddress, msg.Nonce), nil
+	return slots
 }
 
 // ConvertCoinFromNativeToCRC21 convert native token to erc20 token
 func (k Keeper) ConvertCoinFromNativeToCRC21(ctx sdk.Context, sender common.Address, coin sdk.Coin, autoDeploy bool) error {
+	return k.ConvertCoinFromNativeToCRC21To(ctx, sender, sender, coin, autoDeploy)
+}
+
+// ConvertCoinFromNativeToCRC21To convert native token of the sender to erc20 token of the recipient
+func (k Keeper) ConvertCoinFromNativeToCRC21To(ctx sdk.Context, sender, recipient common.Address, coin sdk.Coin, autoDeploy bool) error {
 	if !types.IsValidCoinDenom(coin.Denom) {
 		return fmt.Errorf("coin %s is not supported for conversion", coin.Denom)
 	}
@@ -98,44 +334,58 @@ func (k Keeper) ConvertCoinFromNativeToCRC21(ctx sdk.Context, sender common.Addr
 		if !autoDeploy {
 			return fmt.Errorf("no contract found for the denom %s", coin.Denom)
 		}
+		if !k.GetParams(ctx).IsAutoDeployAllowed(coin.Denom) {
+			return errors.Wrapf(types.ErrDenomNotAllowed, "no contract registered for the denom %s", coin.Denom)
+		}
 		contract, err = k.DeployModuleCRC21(ctx, coin.Denom)
 		if err != nil {
 			return err
 		}
 		k.SetAutoContractForDenom(ctx, coin.Denom, contract)
+		telemetry.IncrCounterWithLabels([]string{types.ModuleName, "auto_deploy", "count"}, 1, conversionLabels(coin.Denom))
 
 		k.Logger(ctx).Info(fmt.Sprintf("contract address %s created for coin denom %s", contract.String(), coin.Denom))
 	}
 
+	amount, err := k.scaleToContractAmount(ctx, coin.Denom, contract, coin.Amount.BigInt())
+	if err != nil {
+		return err
+	}
+
+	// the coins are only taken from the sender if the contract call succeeds, so the conversion is atomic even
+	// if the caller doesn't revert the state on failure
+	cacheCtx, commit := ctx.CacheContext()
 	isSource := types.IsSourceCoin(coin.Denom)
 	coins := sdk.NewCoins(coin)
 	if isSource {
 		// burn coins
-		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(sender.Bytes()), types.ModuleName, sdk.NewCoins(coin))
+		err = k.bankKeeper.SendCoinsFromAccountToModule(cacheCtx, sdk.AccAddress(sender.Bytes()), types.ModuleName, sdk.NewCoins(coin))
 		if err != nil {
 			return err
 		}
-		err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+		err = k.bankKeeper.BurnCoins(cacheCtx, types.ModuleName, coins)
 		if err != nil {
 			return err
 		}
 		// unlock crc tokens
-		_, err = k.CallModuleCRC21(ctx, contract, "transfer_from_cronos_module", sender, coin.Amount.BigInt())
+		_, err = k.CallModuleCRC21(cacheCtx, contract, "transfer_from_cronos_module", recipient, amount)
 		if err != nil {
-			return err
+			return errors.Wrapf(err, "failed to unlock crc21 tokens for %s, the coins are not burned", coin)
 		}
 	} else {
 		// send coins to contract address
-		err = k.bankKeeper.SendCoins(ctx, sdk.AccAddress(sender.Bytes()), sdk.AccAddress(contract.Bytes()), coins)
+		err = k.bankKeeper.SendCoins(cacheCtx, sdk.AccAddress(sender.Bytes()), sdk.AccAddress(contract.Bytes()), coins)
 		if err != nil {
 			return err
 		}
 		// mint crc tokens
-		_, err = k.CallModuleCRC21(ctx, contract, "mint_by_cronos_module", sender, coin.Amount.BigInt())
+		_, err = k.CallModuleCRC21(cacheCtx, contract, "mint_by_cronos_module", recipient, amount)
 		if err != nil {
-			return err
+			return errors.Wrapf(err, "failed to mint crc21 tokens for %s, the coins are not escrowed", coin)
 		}
 	}
+	k.recordConversion(cacheCtx, recipient, coin.Denom, coin.Amount, types.CONVERSION_DIRECTION_TO_CRC20)
+	commit()
 
 	return nil
 }
@@ -147,11 +397,16 @@ func (k Keeper) ConvertCoinFromCRC21ToNative(ctx sdk.Context, contract common.Ad
 		return fmt.Errorf("the contract address %s is not mapped to native token", contract.String())
 	}
 
+	contractAmount, err := k.scaleToContractAmount(ctx, denom, contract, amount.BigInt())
+	if err != nil {
+		return err
+	}
+
 	isSource := types.IsSourceCoin(denom)
 	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
 
 	if isSource {
-		_, err := k.CallModuleCRC21(ctx, contract, "transfer_by_cronos_module", receiver, amount.BigInt())
+		_, err := k.CallModuleCRC21(ctx, contract, "transfer_by_cronos_module", receiver, contractAmount)
 		if err != nil {
 			return err
 		}
@@ -172,15 +427,68 @@ func (k Keeper) ConvertCoinFromCRC21ToNative(ctx sdk.Context, contract common.Ad
 			return err
 		}
 
-		_, err = k.CallModuleCRC21(ctx, contract, "burn_by_cronos_module", receiver, amount.BigInt())
+		_, err = k.CallModuleCRC21(ctx, contract, "burn_by_cronos_module", receiver, contractAmount)
 		if err != nil {
 			return err
 		}
 	}
+	k.recordConversion(ctx, receiver, denom, amount, types.CONVERSION_DIRECTION_TO_NATIVE)
 
 	return nil
 }
 
+// CheckDenomConvertible rejects the bond denom and the denoms on the conversion blocklist with ErrDenomNotConvertible
+func (k Keeper) CheckDenomConvertible(ctx sdk.Context, denom string) error {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	if denom == bondDenom {
+		return errors.Wrapf(types.ErrDenomNotConvertible, "%s is the bond denom", denom)
+	}
+	if k.GetParams(ctx).IsConversionBlocked(denom) {
+		return errors.Wrapf(types.ErrDenomNotConvertible, "%s is blocklisted", denom)
+	}
+	return nil
+}
+
+// checkMinConversionAmount rejects the conversions of amounts below the minimum of the denom with ErrAmountTooSmall
+func (k Keeper) checkMinConversionAmount(ctx sdk.Context, coin sdk.Coin) error {
+	minimum := k.GetParams(ctx).GetMinConversionAmount(coin.Denom)
+	if coin.Amount.LT(minimum) {
+		return errors.Wrapf(types.ErrAmountTooSmall, "%s is below the minimum %s%s", coin, minimum, coin.Denom)
+	}
+	return nil
+}
+
+// ConvertCoin convert a native coin to the crc21 tokens of its registered contract, the coin is escrowed and the
+// tokens are minted to the evm address of the sender.
+func (k Keeper) ConvertCoin(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) (common.Address, error) {
+	if err := k.CheckDenomConvertible(ctx, coin.Denom); err != nil {
+		return common.Address{}, err
+	}
+	if err := k.checkMinConversionAmount(ctx, coin); err != nil {
+		return common.Address{}, err
+	}
+	contract, found := k.GetContractByDenom(ctx, coin.Denom)
+	if !found {
+		return common.Address{}, errors.Wrapf(sdkerrors.ErrInvalidRequest, "no contract found for the denom %s", coin.Denom)
+	}
+	spendable := k.bankKeeper.SpendableCoins(ctx, sender).AmountOf(coin.Denom)
+	if spendable.LT(coin.Amount) {
+		return common.Address{}, errors.Wrapf(sdkerrors.ErrInsufficientFunds, "spendable balance %s%s is smaller than %s", spendable, coin.Denom, coin)
+	}
+	converted, err := k.chargeConversionFees(ctx, sender, sdk.NewCoins(coin))
+	if err != nil {
+		return common.Address{}, err
+	}
+	coin = sdk.NewCoin(coin.Denom, converted.AmountOf(coin.Denom))
+	if err := k.ConvertCoinFromNativeToCRC21(ctx, common.BytesToAddress(sender.Bytes()), coin, false); err != nil {
+		return common.Address{}, err
+	}
+	return contract, nil
+}
+
 // ConvertCoinsFromNativeToCRC21 convert native tokens to erc20 tokens
 func (k Keeper) ConvertCoinsFromNativeToCRC21(ctx sdk.Context, sender common.Address, coins sdk.Coins, autoDeploy bool) error {
 	for _, coin := range coins {
@@ -190,3 +498,65 @@ func (k Keeper) ConvertCoinsFromNativeToCRC21(ctx sdk.Context, sender common.Add
 	}
 	return nil
 }
+
+// GetDenomDecimals returns the decimals of the display unit declared in the bank metadata of the denom,
+// found is false and the default decimals are returned when the metadata don't declare it.
+func (k Keeper) GetDenomDecimals(ctx sdk.Context, denom string) (decimals uint8, found bool, err error) {
+	metadata, exist := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !exist || len(metadata.Display) == 0 {
+		return types.DefaultCRC21Decimals, false, nil
+	}
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom != metadata.Display && !slices.Contains(unit.Aliases, metadata.Display) {
+			continue
+		}
+		if unit.Exponent > math.MaxUint8 {
+			return 0, false, fmt.Errorf("exponent %d of denom %s exceeds the maximum decimals", unit.Exponent, denom)
+		}
+		return uint8(unit.Exponent), true, nil
+	}
+	return types.DefaultCRC21Decimals, false, nil
+}
+
+// scaleToContractAmount converts the amount of native coin to the amount of crc21 tokens,
+// the amount is kept as is unless the coin declares decimals that differ from the contract ones, amounts which
+// don't fit in the uint256 balances of the contract once scaled are rejected before calling it.
+func (k Keeper) scaleToContractAmount(ctx sdk.Context, denom string, contract common.Address, amount *big.Int) (*big.Int, error) {
+	coinDecimals, contractDecimals, found, err := k.getConversionDecimals(ctx, denom, contract)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		contractDecimals = coinDecimals
+	}
+	return types.ScaleAmount(amount, coinDecimals, contractDecimals)
+}
+
+// ScaleToNativeAmount converts the amount of crc21 tokens to the amount of native coin, it's the reverse of the
+// scaling applied when converting native coins, amounts which are not divisible by the scaling factor are rejected
+// so no dust is left in the contract.
+func (k Keeper) ScaleToNativeAmount(ctx sdk.Context, denom string, contract common.Address, amount *big.Int) (*big.Int, error) {
+	coinDecimals, contractDecimals, found, err := k.getConversionDecimals(ctx, denom, contract)
+	if err != nil || !found {
+		return amount, err
+	}
+	return types.ScaleAmount(amount, contractDecimals, coinDecimals)
+}
+
+// getConversionDecimals returns the decimals of the coin and of its contract, found is false when the coin
+// doesn't declare decimals in its metadata, in which case the contract is not queried.
+func (k Keeper) getConversionDecimals(ctx sdk.Context, denom string, contract common.Address) (coinDecimals, contractDecimals uint8, found bool, err error) {
+	coinDecimals, found, err = k.GetDenomDecimals(ctx, denom)
+	if err != nil || !found {
+		return 0, 0, false, err
+	}
+	ret, err := k.CallModuleCRC21(ctx, contract, "decimals")
+	if err != nil {
+		return 0, 0, false, err
+	}
+	decimals := new(big.Int).SetBytes(ret)
+	if !decimals.IsUint64() || decimals.Uint64() > math.MaxUint8 {
+		return 0, 0, false, fmt.Errorf("invalid decimals of contract %s", contract.Hex())
+	}
+	return coinDecimals, uint8(decimals.Uint64()), true, nil
+}
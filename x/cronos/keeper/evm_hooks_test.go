@@ -6,11 +6,14 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	cronosmodulekeeper "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
 	handlers "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper/evmhandlers"
@@ -70,7 +73,7 @@ func (suite *KeeperTestSuite) TestEvmHooks() {
 		{
 			"success send to account",
 			func() {
-				suite.app.CronosKeeper.SetExternalContractForDenom(suite.ctx, denom, contract)
+				suite.app.CronosKeeper.SetExternalContractMapping(suite.ctx, denom, contract)
 				coin := sdk.NewCoin(denom, sdkmath.NewInt(100))
 				err := suite.MintCoins(sdk.AccAddress(contract.Bytes()), sdk.NewCoins(coin))
 				suite.Require().NoError(err)
@@ -111,15 +114,19 @@ func (suite *KeeperTestSuite) TestEvmHooks() {
 					suite.app.EncodingConfig().Codec,
 					suite.app.GetKey(types.StoreKey),
 					suite.app.GetKey(types.MemStoreKey),
+					suite.app.GetObjKey(types.ObjectStoreKey),
 					suite.app.BankKeeper,
 					keepertest.IbcKeeperMock{},
 					suite.app.EvmKeeper,
 					suite.app.AccountKeeper,
+					suite.app.DistrKeeper,
+					suite.app.StakingKeeper,
+					suite.app.IBCKeeper.ChannelKeeper,
 					authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 				)
 				suite.app.CronosKeeper = cronosKeeper
 
-				suite.app.CronosKeeper.SetExternalContractForDenom(suite.ctx, denom, contract)
+				suite.app.CronosKeeper.SetExternalContractMapping(suite.ctx, denom, contract)
 				coin := sdk.NewCoin(denom, sdkmath.NewInt(100))
 				err := suite.MintCoins(sdk.AccAddress(contract.Bytes()), sdk.NewCoins(coin))
 				suite.Require().NoError(err)
@@ -156,3 +163,88 @@ func (suite *KeeperTestSuite) TestEvmHooks() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSendToIbcFromContract() {
+	holder := common.BytesToAddress(suite.address.Bytes())
+	coin := sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(100))
+
+	testCases := []struct {
+		name      string
+		recipient string
+		expErr    bool
+	}{
+		{"packet queued", "cosmos1recipient", false},
+		{"invalid ibc send, the hook fails", "", true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			var transfers []*ibctransfertypes.MsgTransfer
+			cronosKeeper := *cronosmodulekeeper.NewKeeper(
+				suite.app.EncodingConfig().Codec,
+				suite.app.GetKey(types.StoreKey),
+				suite.app.GetKey(types.MemStoreKey),
+				suite.app.GetObjKey(types.ObjectStoreKey),
+				suite.app.BankKeeper,
+				keepertest.RecordingIbcKeeperMock{Transfers: &transfers},
+				suite.app.EvmKeeper,
+				suite.app.AccountKeeper,
+				suite.app.DistrKeeper,
+				suite.app.StakingKeeper,
+				suite.app.IBCKeeper.ChannelKeeper,
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			)
+			suite.app.CronosKeeper = cronosKeeper
+			hook := cronosmodulekeeper.NewLogProcessEvmHook(handlers.NewSendToIbcV2Handler(suite.app.BankKeeper, cronosKeeper))
+
+			// the holder owns crc20 tokens of the auto-deployed contract
+			suite.Require().NoError(suite.MintCoins(sdk.AccAddress(holder.Bytes()), sdk.NewCoins(coin)))
+			suite.Require().NoError(cronosKeeper.ConvertCoinFromNativeToCRC21(suite.ctx, holder, coin, true))
+			contract, found := cronosKeeper.GetContractByDenom(suite.ctx, coin.Denom)
+			suite.Require().True(found)
+
+			// the contract burns the tokens of the holder and emits __CronosSendToIbc
+			data, err := types.ModuleCRC21Contract.ABI.Pack("send_to_ibc", tc.recipient, big.NewInt(40), big.NewInt(0), []byte{})
+			suite.Require().NoError(err)
+			msg := &core.Message{
+				From:              holder,
+				To:                &contract,
+				Value:             big.NewInt(0),
+				GasLimit:          cronosmodulekeeper.DefaultGasCap,
+				GasPrice:          big.NewInt(0),
+				Data:              data,
+				SkipAccountChecks: true,
+			}
+			res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+			suite.Require().NoError(err)
+			suite.Require().False(res.Failed(), res.VmError)
+			receipt := &ethtypes.Receipt{Logs: evmtypes.LogsToEthereum(res.Logs)}
+			suite.Require().Len(receipt.Logs, 2)
+
+			err = hook.PostTxProcessing(suite.ctx, msg, receipt)
+			if tc.expErr {
+				// the evm keeper reverts the whole tx when the hook fails
+				suite.Require().Error(err)
+				suite.Require().Empty(transfers)
+				return
+			}
+			suite.Require().NoError(err)
+
+			sent := sdk.NewCoin(coin.Denom, sdkmath.NewInt(40))
+			suite.Require().Len(transfers, 1)
+			suite.Require().Equal(ibctransfertypes.PortID, transfers[0].SourcePort)
+			suite.Require().Equal("channel-0", transfers[0].SourceChannel)
+			suite.Require().Equal(sent, transfers[0].Token)
+			suite.Require().Equal(sdk.AccAddress(holder.Bytes()).String(), transfers[0].Sender)
+			suite.Require().Equal(tc.recipient, transfers[0].Receiver)
+
+			// the escrowed vouchers are released to the holder to be transferred
+			suite.Require().Equal(sent, suite.GetBalance(sdk.AccAddress(holder.Bytes()), coin.Denom))
+			suite.Require().Equal(coin.Sub(sent), suite.GetBalance(sdk.AccAddress(contract.Bytes()), coin.Denom))
+			ret, err := cronosKeeper.CallModuleCRC21(suite.ctx, contract, "balanceOf", holder)
+			suite.Require().NoError(err)
+			suite.Require().Equal(big.NewInt(60), new(big.Int).SetBytes(ret))
+		})
+	}
+}
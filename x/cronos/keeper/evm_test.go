@@ -1,14 +1,24 @@
 package keeper_test
 
 import (
+	"fmt"
 	"math/big"
 
 	sdkmath "cosmossdk.io/math"
+	cronosmodulekeeper "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
+	keepertest "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper/mock"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
 func (suite *KeeperTestSuite) TestDeployContract() {
@@ -121,3 +131,469 @@ func (suite *KeeperTestSuite) TestSourceTokenConversion() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(0, big.NewInt(100).Cmp(big.NewInt(0).SetBytes(ret)))
 }
+
+func (suite *KeeperTestSuite) TestDeployContractDecimals() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+
+	// no metadata, the default decimals are used
+	contract, err := keeper.DeployModuleCRC21(suite.ctx, "test")
+	suite.Require().NoError(err)
+	ret, err := keeper.CallModuleCRC21(suite.ctx, contract, "decimals")
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(types.DefaultCRC21Decimals), big.NewInt(0).SetBytes(ret).Uint64())
+
+	for _, decimals := range []uint32{8, 18} {
+		denom := fmt.Sprintf("test%d", decimals)
+		suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+			Base:    denom,
+			Display: "display" + denom,
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: denom, Exponent: 0},
+				{Denom: "display" + denom, Exponent: decimals},
+			},
+		})
+		contract, err := keeper.DeployModuleCRC21(suite.ctx, denom)
+		suite.Require().NoError(err)
+		ret, err := keeper.CallModuleCRC21(suite.ctx, contract, "decimals")
+		suite.Require().NoError(err)
+		suite.Require().Equal(uint64(decimals), big.NewInt(0).SetBytes(ret).Uint64())
+	}
+}
+
+func (suite *KeeperTestSuite) TestTokenConversionScaling() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	address := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	cosmosAddress := sdk.AccAddress(address.Bytes())
+
+	setDecimals := func(denom string, decimals uint32) {
+		suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+			Base:    denom,
+			Display: "u" + denom,
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: denom, Exponent: 0},
+				{Denom: "u" + denom, Exponent: decimals},
+			},
+		})
+	}
+
+	// contract with 18 decimals mapped to a coin with 6 decimals
+	setDecimals("eighteen", 18)
+	contract, err := keeper.DeployModuleCRC21(suite.ctx, "eighteen")
+	suite.Require().NoError(err)
+	denom := "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+	setDecimals(denom, 6)
+	suite.Require().NoError(keeper.SetExternalContractMapping(suite.ctx, denom, contract))
+
+	amount := big.NewInt(100)
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount)))
+	suite.Require().NoError(suite.MintCoins(cosmosAddress, coins))
+	suite.Require().NoError(keeper.ConvertCoinsFromNativeToCRC21(suite.ctx, address, coins, false))
+
+	ret, err := keeper.CallModuleCRC21(suite.ctx, contract, "balanceOf", address)
+	suite.Require().NoError(err)
+	suite.Require().Equal(new(big.Int).Mul(amount, big.NewInt(1e12)), big.NewInt(0).SetBytes(ret))
+
+	suite.Require().NoError(keeper.ConvertCoinFromCRC21ToNative(suite.ctx, contract, address, coins[0].Amount))
+	ret, err = keeper.CallModuleCRC21(suite.ctx, contract, "balanceOf", address)
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, big.NewInt(0).SetBytes(ret).Sign())
+	suite.Require().Equal(amount, suite.app.BankKeeper.GetBalance(suite.ctx, cosmosAddress, denom).Amount.BigInt())
+}
+
+func (suite *KeeperTestSuite) TestTokenConversionOverflow() {
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	// the largest amount of 6 decimals which fits in an uint256 once scaled to 18 decimals
+	maxAmount := new(big.Int).Quo(maxUint256, big.NewInt(1e12))
+	denom := "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+
+	testCases := []struct {
+		name   string
+		amount *big.Int
+		expErr error
+	}{
+		{"boundary", maxAmount, nil},
+		{"one unit over the boundary", new(big.Int).Add(maxAmount, big.NewInt(1)), types.ErrAmountOverflow},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			keeper := suite.app.CronosKeeper
+
+			priv, err := ethsecp256k1.GenerateKey()
+			suite.Require().NoError(err)
+			address := common.BytesToAddress(priv.PubKey().Address().Bytes())
+			cosmosAddress := sdk.AccAddress(address.Bytes())
+
+			// contract with 18 decimals mapped to a coin with 6 decimals
+			for denom, decimals := range map[string]uint32{"eighteen": 18, denom: 6} {
+				suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+					Base:    denom,
+					Display: "u" + denom,
+					DenomUnits: []*banktypes.DenomUnit{
+						{Denom: denom, Exponent: 0},
+						{Denom: "u" + denom, Exponent: decimals},
+					},
+				})
+			}
+			contract, err := keeper.DeployModuleCRC21(suite.ctx, "eighteen")
+			suite.Require().NoError(err)
+			suite.Require().NoError(keeper.SetExternalContractMapping(suite.ctx, denom, contract))
+
+			coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(tc.amount)))
+			suite.Require().NoError(suite.MintCoins(cosmosAddress, coins))
+			err = keeper.ConvertCoinsFromNativeToCRC21(suite.ctx, address, coins, false)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				// rejected before moving the coins
+				suite.Require().Equal(coins, suite.app.BankKeeper.GetAllBalances(suite.ctx, cosmosAddress))
+				return
+			}
+			suite.Require().NoError(err)
+			ret, err := keeper.CallModuleCRC21(suite.ctx, contract, "balanceOf", address)
+			suite.Require().NoError(err)
+			suite.Require().Equal(new(big.Int).Mul(maxAmount, big.NewInt(1e12)).String(), big.NewInt(0).SetBytes(ret).String())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConversionRevertedByContract() {
+	reason := "mint disabled"
+	// the runtime code copies the revert data appended to it and reverts with it
+	revertData, err := abi.Arguments{{Type: abi.Type{T: abi.StringTy}}}.Pack(reason)
+	suite.Require().NoError(err)
+	revertData = append(crypto.Keccak256([]byte("Error(string)"))[:4], revertData...)
+	code := []byte{
+		0x60, byte(len(revertData)), // PUSH1 size
+		0x60, 12, // PUSH1 offset of the revert data
+		0x60, 0x00, // PUSH1 0
+		0x39,                        // CODECOPY
+		0x60, byte(len(revertData)), // PUSH1 size
+		0x60, 0x00, // PUSH1 0
+		0xfd, // REVERT
+	}
+	code = append(code, revertData...)
+	// not a precompile address
+	contract := common.BigToAddress(big.NewInt(0x1000))
+
+	testCases := []struct {
+		name  string
+		denom string
+	}{
+		{"voucher, the escrow is reverted", "ibc/0000000000000000000000000000000000000000000000000000000000000000"},
+		{"source token, the burn is reverted", "cronos" + contract.Hex()},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			keeper := suite.app.CronosKeeper
+			codeHash := crypto.Keccak256(code)
+			suite.app.EvmKeeper.SetCode(suite.ctx, codeHash, code)
+			suite.Require().NoError(suite.app.EvmKeeper.SetAccount(suite.ctx, contract, statedb.Account{CodeHash: codeHash}))
+			suite.Require().NoError(keeper.SetExternalContractMapping(suite.ctx, tc.denom, contract))
+
+			address := common.BigToAddress(big.NewInt(0x2000))
+			cosmosAddress := sdk.AccAddress(address.Bytes())
+			coins := sdk.NewCoins(sdk.NewCoin(tc.denom, sdkmath.NewInt(100)))
+			suite.Require().NoError(suite.MintCoins(cosmosAddress, coins))
+			supply := suite.app.BankKeeper.GetSupply(suite.ctx, tc.denom)
+
+			// the state is not reverted by the caller
+			err := keeper.ConvertCoinsFromNativeToCRC21(suite.ctx, address, coins, false)
+			suite.Require().ErrorContains(err, "execution reverted: "+reason)
+			suite.Require().Equal(coins, suite.app.BankKeeper.GetAllBalances(suite.ctx, cosmosAddress))
+			suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, sdk.AccAddress(contract.Bytes())).IsZero())
+			suite.Require().Equal(supply, suite.app.BankKeeper.GetSupply(suite.ctx, tc.denom))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDeployContractTraceName() {
+	testCases := []struct {
+		name         string
+		denom        string
+		expectedName string
+	}{
+		{"single hop", SingleHopIbcDenom, "transfer/channel-0/uatom"},
+		{"multi hop", MultiHopIbcDenom, "transfer/channel-1/transfer/channel-5/uatom"},
+		{"unknown hash", UnknownTraceIbcDenom, ""},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.app.CronosKeeper = *cronosmodulekeeper.NewKeeper(
+				suite.app.EncodingConfig().Codec,
+				suite.app.GetKey(types.StoreKey),
+				suite.app.GetKey(types.MemStoreKey),
+				suite.app.GetObjKey(types.ObjectStoreKey),
+				suite.app.BankKeeper,
+				keepertest.IbcKeeperMock{},
+				suite.app.EvmKeeper,
+				suite.app.AccountKeeper,
+				suite.app.DistrKeeper,
+				suite.app.StakingKeeper,
+				suite.app.IBCKeeper.ChannelKeeper,
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			)
+			keeper := suite.app.CronosKeeper
+
+			contract, err := keeper.DeployModuleCRC21(suite.ctx, tc.denom)
+			suite.Require().NoError(err)
+
+			ret, err := keeper.CallModuleCRC21(suite.ctx, contract, "name")
+			suite.Require().NoError(err)
+			name, err := types.ModuleCRC21Contract.ABI.Unpack("name", ret)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedName, name[0])
+
+			ret, err = keeper.CallModuleCRC21(suite.ctx, contract, "symbol")
+			suite.Require().NoError(err)
+			symbol, err := types.ModuleCRC21Contract.ABI.Unpack("symbol", ret)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.denom, symbol[0])
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDeployContractMetadataName() {
+	testCases := []struct {
+		name           string
+		metadata       *banktypes.Metadata
+		expectedName   string
+		expectedSymbol string
+	}{
+		{"no metadata", nil, "transfer/channel-0/uatom", SingleHopIbcDenom},
+		{
+			"display only",
+			&banktypes.Metadata{Display: "atom"},
+			"transfer/channel-0/uatom",
+			"ATOM",
+		},
+		{
+			"name only",
+			&banktypes.Metadata{Name: "Cosmos Hub Atom"},
+			"Cosmos Hub Atom",
+			SingleHopIbcDenom,
+		},
+		{
+			"full metadata",
+			&banktypes.Metadata{Name: "Cosmos Hub Atom", Symbol: "ATOM", Display: "atom"},
+			"Cosmos Hub Atom",
+			"ATOM",
+		},
+		{
+			"sanitized symbol",
+			&banktypes.Metadata{Name: "Cosmos Hub Atom", Symbol: "u-atom.x/long_symbol"},
+			"Cosmos Hub Atom",
+			"UATOMXLONGS",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.app.CronosKeeper = *cronosmodulekeeper.NewKeeper(
+				suite.app.EncodingConfig().Codec,
+				suite.app.GetKey(types.StoreKey),
+				suite.app.GetKey(types.MemStoreKey),
+				suite.app.GetObjKey(types.ObjectStoreKey),
+				suite.app.BankKeeper,
+				keepertest.IbcKeeperMock{},
+				suite.app.EvmKeeper,
+				suite.app.AccountKeeper,
+				suite.app.DistrKeeper,
+				suite.app.StakingKeeper,
+				suite.app.IBCKeeper.ChannelKeeper,
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			)
+			keeper := suite.app.CronosKeeper
+			if tc.metadata != nil {
+				tc.metadata.Base = SingleHopIbcDenom
+				suite.app.BankKeeper.SetDenomMetaData(suite.ctx, *tc.metadata)
+			}
+
+			contract, err := keeper.DeployModuleCRC21(suite.ctx, SingleHopIbcDenom)
+			suite.Require().NoError(err)
+
+			ret, err := keeper.CallModuleCRC21(suite.ctx, contract, "name")
+			suite.Require().NoError(err)
+			name, err := types.ModuleCRC21Contract.ABI.Unpack("name", ret)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedName, name[0])
+
+			ret, err = keeper.CallModuleCRC21(suite.ctx, contract, "symbol")
+			suite.Require().NoError(err)
+			symbol, err := types.ModuleCRC21Contract.ABI.Unpack("symbol", ret)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedSymbol, symbol[0])
+			if tc.expectedSymbol != SingleHopIbcDenom {
+				// the content of the default long symbol is cleared
+				slot := crypto.Keccak256Hash(common.BigToHash(big.NewInt(5)).Bytes())
+				suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, contract, slot))
+			}
+
+			// the denom is still the native denom of the contract
+			ret, err = keeper.CallModuleCRC21(suite.ctx, contract, "native_denom")
+			suite.Require().NoError(err)
+			denom, err := types.ModuleCRC21Contract.ABI.Unpack("native_denom", ret)
+			suite.Require().NoError(err)
+			suite.Require().Equal(SingleHopIbcDenom, denom[0])
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRedeployContract() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+	denom := "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	msgServer := cronosmodulekeeper.NewMsgServerImpl(keeper)
+
+	holders := []common.Address{common.BigToAddress(big.NewInt(1)), common.BigToAddress(big.NewInt(2))}
+	for i, holder := range holders {
+		coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(int64(i+1)*100)))
+		suite.Require().NoError(suite.MintCoins(sdk.AccAddress(holder.Bytes()), coins))
+		suite.Require().NoError(keeper.ConvertCoinsFromNativeToCRC21(suite.ctx, holder, coins, true))
+	}
+	oldContract, found := keeper.GetContractByDenom(suite.ctx, denom)
+	suite.Require().True(found)
+
+	_, err := msgServer.RedeployContract(suite.ctx, types.NewMsgRedeployContract(authority, denom))
+	suite.Require().ErrorIs(err, types.ErrContractCodeExists)
+
+	// the code of the contract is lost, its storage is left, with a corrupted owner
+	acc := suite.app.EvmKeeper.GetAccount(suite.ctx, oldContract)
+	acc.CodeHash = evmtypes.EmptyCodeHash
+	suite.Require().NoError(suite.app.EvmKeeper.SetAccount(suite.ctx, oldContract, *acc))
+	suite.app.EvmKeeper.SetState(suite.ctx, oldContract, common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(1)).Bytes())
+
+	_, err = msgServer.RedeployContract(suite.ctx, types.NewMsgRedeployContract(suite.address.String(), denom))
+	suite.Require().Error(err)
+	_, err = msgServer.RedeployContract(suite.ctx, types.NewMsgRedeployContract(authority, "ibc/1111111111111111111111111111111111111111111111111111111111111111"))
+	suite.Require().Error(err)
+
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	rsp, err := msgServer.RedeployContract(ctx, types.NewMsgRedeployContract(authority, denom))
+	suite.Require().NoError(err)
+	newContract := common.HexToAddress(rsp.Contract)
+	suite.Require().NotEqual(oldContract, newContract)
+
+	// the mapping is repointed
+	contract, found := keeper.GetContractByDenom(suite.ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(newContract, contract)
+	_, found = keeper.GetDenomByContract(suite.ctx, oldContract)
+	suite.Require().False(found)
+
+	// the balances, the total supply and the escrow are recovered
+	callUint := func(method string, args ...interface{}) *big.Int {
+		ret, err := keeper.CallModuleCRC21(suite.ctx, newContract, method, args...)
+		suite.Require().NoError(err)
+		return new(big.Int).SetBytes(ret)
+	}
+	for i, holder := range holders {
+		suite.Require().Equal(big.NewInt(int64(i+1)*100), callUint("balanceOf", holder))
+	}
+	suite.Require().Equal(big.NewInt(300), callUint("totalSupply"))
+	suite.Require().Equal(sdkmath.NewInt(300), suite.GetBalance(sdk.AccAddress(newContract.Bytes()), denom).Amount)
+
+	// the owner and the metadata of the new deployment are kept
+	callResult := func(method string) interface{} {
+		ret, err := keeper.CallModuleCRC21(suite.ctx, newContract, method)
+		suite.Require().NoError(err)
+		res, err := types.ModuleCRC21Contract.ABI.Unpack(method, ret)
+		suite.Require().NoError(err)
+		return res[0]
+	}
+	suite.Require().Equal(types.EVMModuleAddress, callResult("owner"))
+	suite.Require().Equal(denom, callResult("native_denom"))
+	suite.Require().Equal(false, callResult("stopped"))
+	suite.Require().True(suite.GetBalance(sdk.AccAddress(oldContract.Bytes()), denom).IsZero())
+	_, broken := cronosmodulekeeper.EscrowSupplyInvariant(keeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	var redeployed bool
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type != "cronos.EventRedeployContract" {
+			continue
+		}
+		typed, err := sdk.ParseTypedEvent(event)
+		suite.Require().NoError(err)
+		suite.Require().Equal(&types.EventRedeployContract{
+			Denom:       denom,
+			OldContract: oldContract.Hex(),
+			NewContract: newContract.Hex(),
+		}, typed)
+		redeployed = true
+	}
+	suite.Require().True(redeployed)
+
+	// the conversions work again
+	suite.Require().NoError(keeper.ConvertCoinFromCRC21ToNative(suite.ctx, newContract, holders[0], sdkmath.NewInt(100)))
+	suite.Require().Equal(sdkmath.NewInt(100), suite.GetBalance(sdk.AccAddress(holders[0].Bytes()), denom).Amount)
+	suite.Require().Equal(big.NewInt(200), callUint("totalSupply"))
+
+	_, err = msgServer.RedeployContract(suite.ctx, types.NewMsgRedeployContract(authority, denom))
+	suite.Require().ErrorIs(err, types.ErrContractCodeExists)
+}
+
+func (suite *KeeperTestSuite) TestDeterministicCRC21Address() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+	denom := "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+	expected := keeper.ModuleCRC21Address(suite.ctx, denom)
+
+	// deploy the same denom from two different nonces of the module account
+	ctx1, _ := suite.ctx.CacheContext()
+	contract1, err := keeper.DeployModuleCRC21(ctx1, denom)
+	suite.Require().NoError(err)
+
+	ctx2, _ := suite.ctx.CacheContext()
+	acc := suite.app.EvmKeeper.GetAccount(ctx2, types.EVMModuleAddress)
+	if acc == nil {
+		acc = statedb.NewEmptyAccount()
+	}
+	acc.Nonce = 100
+	suite.Require().NoError(suite.app.EvmKeeper.SetAccount(ctx2, types.EVMModuleAddress, *acc))
+	_, err = keeper.DeployModuleCRC21(ctx2, "ibc/1111111111111111111111111111111111111111111111111111111111111111")
+	suite.Require().NoError(err)
+	contract2, err := keeper.DeployModuleCRC21(ctx2, denom)
+	suite.Require().NoError(err)
+
+	suite.Require().NotEqual(
+		suite.app.EvmKeeper.GetNonce(ctx1, types.EVMModuleAddress),
+		suite.app.EvmKeeper.GetNonce(ctx2, types.EVMModuleAddress),
+	)
+	suite.Require().Equal(expected, contract1)
+	suite.Require().Equal(expected, contract2)
+
+	// deploying again returns the existing contract
+	contract, err := keeper.DeployModuleCRC21(ctx2, denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, contract)
+	ret, err := keeper.CallModuleCRC21(ctx2, contract, "symbol")
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(ret)
+
+	// the ownership is handed over to the module
+	ret, err = keeper.CallModuleCRC21(ctx2, contract, "owner")
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.EVMModuleAddress, common.BytesToAddress(ret))
+
+	// the address doesn't depend on the metadata of the denom
+	ctx3, _ := suite.ctx.CacheContext()
+	suite.app.BankKeeper.SetDenomMetaData(ctx3, banktypes.Metadata{
+		Base:       denom,
+		Display:    "display",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom}, {Denom: "display", Exponent: 6}},
+	})
+	suite.Require().Equal(expected, keeper.ModuleCRC21Address(ctx3, denom))
+	contract3, err := keeper.DeployModuleCRC21(ctx3, denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, contract3)
+}
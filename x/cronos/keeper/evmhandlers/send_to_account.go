@@ -79,7 +79,11 @@ func (h SendToAccountHandler) Handle(
 
 	contractAddr := sdk.AccAddress(contract.Bytes())
 	recipient := sdk.AccAddress(unpacked[0].(common.Address).Bytes())
-	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(unpacked[1].(*big.Int))))
+	amount, err := h.cronosKeeper.ScaleToNativeAmount(ctx, denom, contract, unpacked[1].(*big.Int))
+	if err != nil {
+		return err
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount)))
 	err = h.bankKeeper.SendCoins(ctx, contractAddr, recipient, coins)
 	if err != nil {
 		return err
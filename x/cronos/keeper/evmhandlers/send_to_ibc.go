@@ -101,10 +101,12 @@ func (h SendToIbcHandler) handle(
 
 	contractAddr := sdk.AccAddress(contract.Bytes())
 	sender := sdk.AccAddress(senderAddress.Bytes())
-	amount := sdkmath.NewIntFromBigInt(amountInt)
-	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	nativeAmount, err := h.cronosKeeper.ScaleToNativeAmount(ctx, denom, contract, amountInt)
+	if err != nil {
+		return err
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(nativeAmount)))
 
-	var err error
 	if types.IsSourceCoin(denom) {
 		// it is a source token, we need to mint coins
 		if err = h.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
@@ -8,7 +8,9 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	cronosmodulekeeper "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
 	evmhandlers "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper/evmhandlers"
 	keepertest "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper/mock"
@@ -58,7 +60,7 @@ func (suite *KeeperTestSuite) TestSendToAccountHandler() {
 		{
 			"success send to account",
 			func() {
-				suite.app.CronosKeeper.SetExternalContractForDenom(suite.ctx, denom, contract)
+				suite.app.CronosKeeper.SetExternalContractMapping(suite.ctx, denom, contract)
 				coin := sdk.NewCoin(denom, sdkmath.NewInt(100))
 				err := suite.MintCoins(sdk.AccAddress(contract.Bytes()), sdk.NewCoins(coin))
 				suite.Require().NoError(err)
@@ -102,6 +104,57 @@ func (suite *KeeperTestSuite) TestSendToAccountHandler() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSendToAccountHandlerScaling() {
+	recipient := common.BigToAddress(big.NewInt(3))
+	ibcDenom := "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+	escrowed := sdkmath.NewInt(100)
+
+	testCases := []struct {
+		msg         string
+		amount      *big.Int
+		expReceived sdkmath.Int
+		expErr      error
+	}{
+		{"scaled down to the coin decimals", new(big.Int).Mul(escrowed.BigInt(), big.NewInt(1e12)), escrowed, nil},
+		{"smallest unit", big.NewInt(1e12), sdkmath.OneInt(), nil},
+		{"one unit below divisibility", big.NewInt(1e12 - 1), sdkmath.ZeroInt(), types.ErrAmountNotDivisible},
+		{"not divisible", new(big.Int).Add(big.NewInt(1e12), big.NewInt(1)), sdkmath.ZeroInt(), types.ErrAmountNotDivisible},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+			// contract with 18 decimals mapped to a coin with 6 decimals
+			for denom, decimals := range map[string]uint32{"eighteen": 18, ibcDenom: 6} {
+				suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+					Base:    denom,
+					Display: "u" + denom,
+					DenomUnits: []*banktypes.DenomUnit{
+						{Denom: denom, Exponent: 0},
+						{Denom: "u" + denom, Exponent: decimals},
+					},
+				})
+			}
+			contract, err := suite.app.CronosKeeper.DeployModuleCRC21(suite.ctx, "eighteen")
+			suite.Require().NoError(err)
+			suite.Require().NoError(suite.app.CronosKeeper.SetExternalContractMapping(suite.ctx, ibcDenom, contract))
+			suite.Require().NoError(suite.MintCoins(sdk.AccAddress(contract.Bytes()), sdk.NewCoins(sdk.NewCoin(ibcDenom, escrowed))))
+
+			input, err := evmhandlers.SendToAccountEvent.Inputs.NonIndexed().Pack(recipient, tc.amount)
+			suite.Require().NoError(err)
+			handler := evmhandlers.NewSendToAccountHandler(suite.app.BankKeeper, suite.app.CronosKeeper)
+			err = handler.Handle(suite.ctx, contract, []common.Hash{evmhandlers.SendToAccountEvent.ID}, input, func(contractAddress common.Address, logSig common.Hash, logData []byte) {})
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+			}
+			suite.Require().Equal(tc.expReceived.String(), suite.GetBalance(sdk.AccAddress(recipient.Bytes()), ibcDenom).Amount.String())
+			suite.Require().Equal(escrowed.Sub(tc.expReceived).String(), suite.GetBalance(sdk.AccAddress(contract.Bytes()), ibcDenom).Amount.String())
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestSendToIbcHandler() {
 	contract := common.BigToAddress(big.NewInt(1))
 	sender := common.BigToAddress(big.NewInt(2))
@@ -142,7 +195,7 @@ func (suite *KeeperTestSuite) TestSendToIbcHandler() {
 		{
 			"non IBC denom, expect fail",
 			func() {
-				suite.app.CronosKeeper.SetExternalContractForDenom(suite.ctx, invalidDenom, contract)
+				suite.app.CronosKeeper.SetExternalContractMapping(suite.ctx, invalidDenom, contract)
 				coin := sdk.NewCoin(invalidDenom, sdkmath.NewInt(100))
 				err := suite.MintCoins(sdk.AccAddress(contract.Bytes()), sdk.NewCoins(coin))
 				suite.Require().NoError(err)
@@ -166,7 +219,7 @@ func (suite *KeeperTestSuite) TestSendToIbcHandler() {
 		{
 			"success send to ibc",
 			func() {
-				suite.app.CronosKeeper.SetExternalContractForDenom(suite.ctx, validDenom, contract)
+				suite.app.CronosKeeper.SetExternalContractMapping(suite.ctx, validDenom, contract)
 				coin := sdk.NewCoin(validDenom, sdkmath.NewInt(100))
 				err := suite.MintCoins(sdk.AccAddress(contract.Bytes()), sdk.NewCoins(coin))
 				suite.Require().NoError(err)
@@ -197,10 +250,14 @@ func (suite *KeeperTestSuite) TestSendToIbcHandler() {
 				suite.app.EncodingConfig().Codec,
 				suite.app.GetKey(types.StoreKey),
 				suite.app.GetKey(types.MemStoreKey),
+				suite.app.GetObjKey(types.ObjectStoreKey),
 				suite.app.BankKeeper,
 				keepertest.IbcKeeperMock{},
 				suite.app.EvmKeeper,
 				suite.app.AccountKeeper,
+				suite.app.DistrKeeper,
+				suite.app.StakingKeeper,
+				suite.app.IBCKeeper.ChannelKeeper,
 				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 			)
 			handler := evmhandlers.NewSendToIbcHandler(suite.app.BankKeeper, cronosKeeper)
@@ -224,6 +281,7 @@ func (suite *KeeperTestSuite) TestSendToIbcV2Handler() {
 	validDenom := CorrectIbcDenom
 	var data []byte
 	var topics []common.Hash
+	var transfers []*ibctransfertypes.MsgTransfer
 
 	testCases := []struct {
 		msg       string
@@ -259,7 +317,7 @@ func (suite *KeeperTestSuite) TestSendToIbcV2Handler() {
 		{
 			"non IBC denom, expect fail",
 			func() {
-				suite.app.CronosKeeper.SetExternalContractForDenom(suite.ctx, invalidDenom, contract)
+				suite.app.CronosKeeper.SetExternalContractMapping(suite.ctx, invalidDenom, contract)
 				coin := sdk.NewCoin(invalidDenom, sdkmath.NewInt(100))
 				err := suite.MintCoins(sdk.AccAddress(contract.Bytes()), sdk.NewCoins(coin))
 				suite.Require().NoError(err)
@@ -285,7 +343,7 @@ func (suite *KeeperTestSuite) TestSendToIbcV2Handler() {
 		{
 			"success send to ibc",
 			func() {
-				suite.app.CronosKeeper.SetExternalContractForDenom(suite.ctx, validDenom, contract)
+				suite.app.CronosKeeper.SetExternalContractMapping(suite.ctx, validDenom, contract)
 				coin := sdk.NewCoin(validDenom, sdkmath.NewInt(100))
 				err := suite.MintCoins(sdk.AccAddress(contract.Bytes()), sdk.NewCoins(coin))
 				suite.Require().NoError(err)
@@ -305,7 +363,38 @@ func (suite *KeeperTestSuite) TestSendToIbcV2Handler() {
 				)
 				data = input
 			},
-			func() {},
+			func() {
+				suite.Require().Len(transfers, 1)
+				suite.Require().Equal("channel-0", transfers[0].SourceChannel)
+			},
+			nil,
+		},
+		{
+			"send voucher with another channel id, sent through its source channel",
+			func() {
+				suite.app.CronosKeeper.SetExternalContractMapping(suite.ctx, validDenom, contract)
+				coin := sdk.NewCoin(validDenom, sdkmath.NewInt(100))
+				err := suite.MintCoins(sdk.AccAddress(contract.Bytes()), sdk.NewCoins(coin))
+				suite.Require().NoError(err)
+
+				topics = []common.Hash{
+					evmhandlers.SendToIbcEvent.ID,
+					sender.Hash(),
+					common.BytesToHash(big.NewInt(5).Bytes()),
+				}
+				input, _ := evmhandlers.SendToIbcEventV2.Inputs.NonIndexed().Pack(
+					recipient,
+					coin.Amount.BigInt(),
+					[]byte{},
+				)
+				data = input
+			},
+			func() {
+				// the channel id of the event is ignored for the vouchers
+				suite.Require().Len(transfers, 1)
+				suite.Require().Equal("channel-0", transfers[0].SourceChannel)
+				suite.Require().True(suite.GetBalance(sdk.AccAddress(contract.Bytes()), validDenom).IsZero())
+			},
 			nil,
 		},
 	}
@@ -313,15 +402,20 @@ func (suite *KeeperTestSuite) TestSendToIbcV2Handler() {
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest()
+			transfers = nil
 			// Create Cronos Keeper with mock transfer keeper
 			cronosKeeper := *cronosmodulekeeper.NewKeeper(
 				suite.app.EncodingConfig().Codec,
 				suite.app.GetKey(types.StoreKey),
 				suite.app.GetKey(types.MemStoreKey),
+				suite.app.GetObjKey(types.ObjectStoreKey),
 				suite.app.BankKeeper,
-				keepertest.IbcKeeperMock{},
+				keepertest.RecordingIbcKeeperMock{Transfers: &transfers},
 				suite.app.EvmKeeper,
 				suite.app.AccountKeeper,
+				suite.app.DistrKeeper,
+				suite.app.StakingKeeper,
+				suite.app.IBCKeeper.ChannelKeeper,
 				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 			)
 			handler := evmhandlers.NewSendToIbcV2Handler(suite.app.BankKeeper, cronosKeeper)
@@ -414,10 +508,14 @@ func (suite *KeeperTestSuite) TestSendCroToIbcHandler() {
 				suite.app.EncodingConfig().Codec,
 				suite.app.GetKey(types.StoreKey),
 				suite.app.GetKey(types.MemStoreKey),
+				suite.app.GetObjKey(types.ObjectStoreKey),
 				suite.app.BankKeeper,
 				keepertest.IbcKeeperMock{},
 				suite.app.EvmKeeper,
 				suite.app.AccountKeeper,
+				suite.app.DistrKeeper,
+				suite.app.StakingKeeper,
+				suite.app.IBCKeeper.ChannelKeeper,
 				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 			)
 			handler := evmhandlers.NewSendCroToIbcHandler(suite.app.BankKeeper, cronosKeeper)
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// chargeConversionFees pays the fees of the coins converted by the messages of the users, the automatic conversions
+// of the received and refunded vouchers are free. The gas token is converted in full, the coins net of the fees are
+// returned.
+func (k Keeper) chargeConversionFees(ctx sdk.Context, sender sdk.AccAddress, coins sdk.Coins) (sdk.Coins, error) {
+	params := k.GetParams(ctx)
+	net := sdk.NewCoins()
+	for _, c := range coins {
+		if c.Denom != params.IbcCroDenom {
+			fee := params.GetConversionFee(c)
+			if fee.IsPositive() {
+				if err := k.payConversionFee(ctx, sender, fee); err != nil {
+					return nil, errors.Wrapf(err, "failed to pay the conversion fee %s", fee)
+				}
+				c = c.Sub(fee)
+			}
+		}
+		net = net.Add(c)
+	}
+	return net, nil
+}
+
+// payConversionFee sends the conversion fee to the configured fee collector, or to the community pool if there's none
+func (k Keeper) payConversionFee(ctx sdk.Context, sender sdk.AccAddress, fee sdk.Coin) error {
+	collector := k.GetParams(ctx).ConversionFeeCollector
+	if collector == "" {
+		return k.distributionKeeper.FundCommunityPool(ctx, sdk.NewCoins(fee), sender)
+	}
+	collectorAddr, err := sdk.AccAddressFromBech32(collector)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoins(ctx, sender, collectorAddr, sdk.NewCoins(fee))
+}
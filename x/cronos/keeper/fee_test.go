@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cronosmodulekeeper "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+)
+
+func (suite *KeeperTestSuite) TestConversionFee() {
+	address := sdk.AccAddress(suite.address.Bytes())
+	collector := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coin := sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(1001))
+
+	testCases := []struct {
+		name        string
+		basisPoints uint32
+		collector   string
+		expFee      int64
+	}{
+		{"zero fee", 0, "", 0},
+		{"fractional fee rounded down", 25, "", 2},
+		{"fee paid to the collector", 25, collector.String(), 2},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			keeper := suite.app.CronosKeeper
+			params := keeper.GetParams(suite.ctx)
+			params.EnableAutoDeployment = true
+			params.ConversionFees = []types.ConversionFee{{Denom: CorrectIbcDenom, BasisPoints: tc.basisPoints}}
+			params.ConversionFeeCollector = tc.collector
+			suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+			suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(coin)))
+			communityPool, err := suite.app.DistrKeeper.FeePool.Get(suite.ctx)
+			suite.Require().NoError(err)
+
+			msgServer := cronosmodulekeeper.NewMsgServerImpl(keeper)
+			_, err = msgServer.ConvertVouchers(suite.ctx, types.NewMsgConvertVouchers(address.String(), sdk.NewCoins(coin)))
+			suite.Require().NoError(err)
+
+			// only the net amount is converted
+			net := coin.Amount.SubRaw(tc.expFee)
+			contract, found := keeper.GetContractByDenom(suite.ctx, coin.Denom)
+			suite.Require().True(found)
+			ret, err := keeper.CallModuleCRC21(suite.ctx, contract, "balanceOf", common.BytesToAddress(address.Bytes()))
+			suite.Require().NoError(err)
+			suite.Require().Equal(net.BigInt(), new(big.Int).SetBytes(ret))
+			suite.Require().Equal(net, suite.GetBalance(sdk.AccAddress(contract.Bytes()), coin.Denom).Amount)
+			suite.Require().True(suite.GetBalance(address, coin.Denom).IsZero())
+
+			newCommunityPool, err := suite.app.DistrKeeper.FeePool.Get(suite.ctx)
+			suite.Require().NoError(err)
+			paid := newCommunityPool.CommunityPool.AmountOf(coin.Denom).Sub(communityPool.CommunityPool.AmountOf(coin.Denom))
+			if tc.collector != "" {
+				suite.Require().True(paid.IsZero())
+				suite.Require().Equal(tc.expFee, suite.GetBalance(collector, coin.Denom).Amount.Int64())
+			} else {
+				suite.Require().Equal(sdkmath.LegacyNewDec(tc.expFee), paid)
+			}
+
+			var emitted bool
+			for _, event := range suite.ctx.EventManager().ABCIEvents() {
+				if event.Type != "cronos.EventConvertVouchers" {
+					continue
+				}
+				typed, err := sdk.ParseTypedEvent(event)
+				suite.Require().NoError(err)
+				suite.Require().Equal(&types.EventConvertVouchers{
+					Sender:    address.String(),
+					Recipient: common.BytesToAddress(address.Bytes()).Hex(),
+					Denom:     coin.Denom,
+					Contract:  contract.Hex(),
+					Amount:    coin.Amount.String(),
+					Fee:       sdkmath.NewInt(tc.expFee).String(),
+				}, typed)
+				emitted = true
+			}
+			suite.Require().True(emitted)
+		})
+	}
+
+	// a fee of the whole amount is rejected
+	suite.SetupTest()
+	params := suite.app.CronosKeeper.GetParams(suite.ctx)
+	params.ConversionFees = []types.ConversionFee{{Denom: CorrectIbcDenom, BasisPoints: types.MaxConversionFeeBasisPoints}}
+	suite.Require().Error(suite.app.CronosKeeper.SetParams(suite.ctx, params))
+}
+
+func (suite *KeeperTestSuite) TestRefundNoConversionFee() {
+	suite.SetupTest()
+	address := sdk.AccAddress(suite.address.Bytes())
+	coin := sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(1001))
+	keeper := suite.app.CronosKeeper
+	params := keeper.GetParams(suite.ctx)
+	params.EnableAutoDeployment = true
+	params.ConversionFees = []types.ConversionFee{{Denom: CorrectIbcDenom, BasisPoints: 1000}}
+	params.ConversionBlocklist = []string{CorrectIbcDenom}
+	params.MinConversionAmounts = []types.ConversionMinimum{{Denom: CorrectIbcDenom, Amount: sdkmath.NewInt(10000)}}
+	params.ConversionQuotas = []types.ConversionQuota{{Denom: CorrectIbcDenom, Amount: sdkmath.NewInt(1)}}
+	suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+	suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(coin)))
+
+	// the refunded vouchers are converted back in full
+	packet := channeltypes.NewPacket(nil, 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.ZeroHeight(), 0)
+	keeper.OnRefundVouchers(suite.ctx, packet, sdk.NewCoins(coin), address.String())
+	contract, found := keeper.GetContractByDenom(suite.ctx, coin.Denom)
+	suite.Require().True(found)
+	ret, err := keeper.CallModuleCRC21(suite.ctx, contract, "balanceOf", common.BytesToAddress(address.Bytes()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(coin.Amount.BigInt(), new(big.Int).SetBytes(ret))
+	suite.Require().Equal(coin.Amount, suite.GetBalance(sdk.AccAddress(contract.Bytes()), coin.Denom).Amount)
+	suite.Require().True(suite.GetBalance(address, coin.Denom).IsZero())
+}
@@ -6,10 +6,12 @@ import (
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -39,6 +41,9 @@ func (k Keeper) ContractByDenom(goCtx context.Context, req *types.ContractByDeno
 
 // DenomByContract query denom by contract
 func (k Keeper) DenomByContract(goCtx context.Context, req *types.DenomByContractRequest) (*types.DenomByContractResponse, error) {
+	if !common.IsHexAddress(req.Contract) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid contract address: %s", req.Contract)
+	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	denom, found := k.GetDenomByContract(ctx, common.HexToAddress(req.Contract))
 	if !found {
@@ -49,6 +54,125 @@ func (k Keeper) DenomByContract(goCtx context.Context, req *types.DenomByContrac
 	}, nil
 }
 
+// TokenMappings query all the token mappings ordered by denom, paginated by the denom key
+func (k Keeper) TokenMappings(goCtx context.Context, req *types.QueryTokenMappingsRequest) (*types.QueryTokenMappingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pageReq, limit, countTotal, err := parseMappingPageRequest(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		mappings []types.TokenMappingInfo
+		nextKey  []byte
+		count    uint64
+	)
+	k.IterateTokenMappings(ctx, pageReq.Key, func(m types.TokenMapping, auto bool) bool {
+		count++
+		if count <= pageReq.Offset {
+			return false
+		}
+		if uint64(len(mappings)) == limit {
+			if nextKey == nil {
+				nextKey = []byte(m.Denom)
+			}
+			return !countTotal
+		}
+		mappings = append(mappings, types.TokenMappingInfo{
+			Denom:        m.Denom,
+			Contract:     m.Contract,
+			IsSource:     types.IsSourceCoin(m.Denom),
+			AutoDeployed: auto,
+		})
+		return false
+	})
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = count
+	}
+	return &types.QueryTokenMappingsResponse{
+		Mappings:   mappings,
+		Pagination: pageRes,
+	}, nil
+}
+
+// EscrowBalances returns the coins escrowed for the mapped denoms, the coins are escrowed by the address of their
+// contract, source tokens are burned instead of escrowed, so they are omitted.
+func (k Keeper) EscrowBalances(goCtx context.Context, req *types.QueryEscrowBalancesRequest) (*types.QueryEscrowBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pageReq, limit, countTotal, err := parseMappingPageRequest(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		balances sdk.Coins
+		nextKey  []byte
+		count    uint64
+	)
+	k.IterateTokenMappings(ctx, pageReq.Key, func(m types.TokenMapping, _ bool) bool {
+		if types.IsSourceCoin(m.Denom) {
+			return false
+		}
+		balance := k.bankKeeper.GetBalance(ctx, sdk.AccAddress(common.HexToAddress(m.Contract).Bytes()), m.Denom)
+		if balance.IsZero() {
+			return false
+		}
+		count++
+		if count <= pageReq.Offset {
+			return false
+		}
+		if uint64(len(balances)) == limit {
+			if nextKey == nil {
+				nextKey = []byte(m.Denom)
+			}
+			return !countTotal
+		}
+		// the mappings are iterated in the order of the denoms
+		balances = append(balances, balance)
+		return false
+	})
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = count
+	}
+	return &types.QueryEscrowBalancesResponse{
+		Balances:   balances,
+		Pagination: pageRes,
+	}, nil
+}
+
+// parseMappingPageRequest validates the pagination of the queries iterating the token mappings, the key is the denom,
+// countTotal is only set when iterating from the beginning.
+func parseMappingPageRequest(pageReq *query.PageRequest) (_ *query.PageRequest, limit uint64, countTotal bool, err error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, 0, false, status.Error(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+	}
+	if pageReq.Reverse {
+		return nil, 0, false, status.Error(codes.InvalidArgument, "reverse pagination is not supported")
+	}
+	limit = pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+		// count total results when the limit is zero/not supplied
+		pageReq.CountTotal = true
+	}
+	return pageReq, limit, pageReq.CountTotal && len(pageReq.Key) == 0, nil
+}
+
 // ReplayBlock replay the eth messages in the block to recover the results of false-failed txs.
 func (k Keeper) ReplayBlock(goCtx context.Context, req *types.ReplayBlockRequest) (*types.ReplayBlockResponse, error) {
 	rsps := make([]*evmtypes.MsgEthereumTxResponse, 0, len(req.Msgs))
@@ -129,8 +253,7 @@ func (k Keeper) Permissions(goCtx context.Context, req *types.QueryPermissionsRe
 	if err != nil {
 		return nil, err
 	}
-	admin := k.GetParams(ctx).CronosAdmin
-	if admin == acc.String() {
+	if k.GetParams(ctx).IsCronosAdmin(acc.String()) {
 		return &types.QueryPermissionsResponse{
 			CanChangeTokenMapping: true,
 			CanTurnBridge:         true,
@@ -142,3 +265,180 @@ func (k Keeper) Permissions(goCtx context.Context, req *types.QueryPermissionsRe
 		CanTurnBridge:         CanTurnBridge == (permissions & CanTurnBridge),
 	}, nil
 }
+
+// SimulateConversion previews the conversion of a native coin by MsgConvertVouchers, the state changes
+// of the evm calls are discarded.
+func (k Keeper) SimulateConversion(goCtx context.Context, req *types.QuerySimulateConversionRequest) (*types.QuerySimulateConversionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	amount, ok := sdkmath.NewIntFromString(req.Amount)
+	if !ok || !amount.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %s", req.Amount)
+	}
+	ctx, _ := sdk.UnwrapSDKContext(goCtx).CacheContext()
+	params := k.GetParams(ctx)
+	if params.ConversionPaused {
+		return nil, status.Error(codes.FailedPrecondition, types.ErrConversionPaused.Error())
+	}
+
+	if req.Denom == params.IbcCroDenom && req.Denom != "" {
+		return &types.QuerySimulateConversionResponse{
+			Amount: amount.Mul(sdkmath.NewIntFromBigInt(types.TenPowTen)).String(),
+		}, nil
+	}
+	if !types.IsValidCoinDenom(req.Denom) {
+		return nil, status.Errorf(codes.InvalidArgument, "coin %s is not supported for conversion", req.Denom)
+	}
+	// the fee is deducted before the conversion like in MsgConvertVouchers
+	fee := params.GetConversionFee(sdk.NewCoin(req.Denom, amount))
+	amount = amount.Sub(fee.Amount)
+
+	contract, found := k.GetContractByDenom(ctx, req.Denom)
+	if !found {
+		if !params.EnableAutoDeployment {
+			return nil, status.Errorf(codes.NotFound, "no contract found for the denom %s", req.Denom)
+		}
+		if !params.IsAutoDeployAllowed(req.Denom) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s: %s", types.ErrDenomNotAllowed, req.Denom)
+		}
+		// the contract is deployed with the decimals of the coin, so the amount is kept as is
+		return &types.QuerySimulateConversionResponse{
+			Contract:   k.ModuleCRC21Address(ctx, req.Denom).Hex(),
+			Amount:     amount.String(),
+			AutoDeploy: true,
+			Fee:        fee,
+		}, nil
+	}
+
+	scaled, err := k.scaleToContractAmount(ctx, req.Denom, contract, amount.BigInt())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QuerySimulateConversionResponse{
+		Contract: contract.Hex(),
+		Amount:   scaled.String(),
+		Fee:      fee,
+	}, nil
+}
+
+// DenomDeployInfo returns the contract mapped to the denom if any, the external contract takes precedence over the
+// auto-deployed one like in the conversions, and whether the auto-deployment is enabled for the denom.
+func (k Keeper) DenomDeployInfo(goCtx context.Context, req *types.QueryDenomDeployInfoRequest) (*types.QueryDenomDeployInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if !types.IsValidCoinDenom(req.Denom) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom: %s", req.Denom)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	rsp := &types.QueryDenomDeployInfoResponse{
+		IsSource:              types.IsSourceCoin(req.Denom),
+		AutoDeploymentEnabled: params.EnableAutoDeployment && params.IsAutoDeployAllowed(req.Denom),
+	}
+	if contract, found := k.getExternalContractByDenom(ctx, req.Denom); found {
+		rsp.Found = true
+		rsp.Contract = contract.Hex()
+	} else if contract, found := k.getAutoContractByDenom(ctx, req.Denom); found {
+		rsp.Found = true
+		rsp.Contract = contract.Hex()
+		rsp.AutoDeployed = true
+	}
+	return rsp, nil
+}
+
+// ContractsByDenoms resolves the contracts of the denoms like GetContractByDenom, in the order of the request
+func (k Keeper) ContractsByDenoms(goCtx context.Context, req *types.QueryContractsByDenomsRequest) (*types.QueryContractsByDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Denoms) > types.MaxContractsByDenomsQuery {
+		return nil, status.Errorf(codes.InvalidArgument, "too many denoms: %d, the maximum is %d", len(req.Denoms), types.MaxContractsByDenomsQuery)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	contracts := make([]types.DenomContract, len(req.Denoms))
+	for i, denom := range req.Denoms {
+		contracts[i].Denom = denom
+		if contract, found := k.GetContractByDenom(ctx, denom); found {
+			contracts[i].Found = true
+			contracts[i].Contract = contract.Hex()
+		}
+	}
+	return &types.QueryContractsByDenomsResponse{Contracts: contracts}, nil
+}
+
+// CRC20Balance returns the balance of the address in the crc20 contract mapped to the denom
+func (k Keeper) CRC20Balance(goCtx context.Context, req *types.QueryCRC20BalanceRequest) (*types.QueryCRC20BalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if !common.IsHexAddress(req.Address) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid evm address: %s", req.Address)
+	}
+	contract, balance, err := k.callCRC20View(goCtx, req.Denom, "balanceOf", common.HexToAddress(req.Address))
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCRC20BalanceResponse{
+		Contract: contract.Hex(),
+		Balance:  balance.String(),
+	}, nil
+}
+
+// CRC20Supply returns the total supply of the crc20 contract mapped to the denom
+func (k Keeper) CRC20Supply(goCtx context.Context, req *types.QueryCRC20SupplyRequest) (*types.QueryCRC20SupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contract, supply, err := k.callCRC20View(goCtx, req.Denom, "totalSupply")
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCRC20SupplyResponse{
+		Contract:    contract.Hex(),
+		TotalSupply: supply.String(),
+	}, nil
+}
+
+// callCRC20View calls a view method returning an uint256 on the contract mapped to the denom,
+// the state changes of the call are discarded.
+func (k Keeper) callCRC20View(goCtx context.Context, denom, method string, args ...interface{}) (common.Address, *big.Int, error) {
+	if !types.IsValidCoinDenom(denom) {
+		return common.Address{}, nil, status.Errorf(codes.InvalidArgument, "invalid denom: %s", denom)
+	}
+	ctx, _ := sdk.UnwrapSDKContext(goCtx).CacheContext()
+	contract, found := k.GetContractByDenom(ctx, denom)
+	if !found {
+		return common.Address{}, nil, status.Errorf(codes.NotFound, "no contract found for the denom %s", denom)
+	}
+	ret, err := k.CallModuleCRC21(ctx, contract, method, args...)
+	if err != nil {
+		return common.Address{}, nil, status.Error(codes.Internal, err.Error())
+	}
+	values, err := types.ModuleCRC21Contract.ABI.Unpack(method, ret)
+	if err != nil {
+		return common.Address{}, nil, status.Errorf(codes.Internal, "invalid %s result of contract %s: %s", method, contract.Hex(), err)
+	}
+	value, ok := values[0].(*big.Int)
+	if !ok {
+		return common.Address{}, nil, status.Errorf(codes.Internal, "invalid %s result of contract %s", method, contract.Hex())
+	}
+	return contract, value, nil
+}
+
+// ConversionHistory returns the recent conversions of the evm address, it's empty if the history is disabled
+func (k Keeper) ConversionHistory(goCtx context.Context, req *types.QueryConversionHistoryRequest) (*types.QueryConversionHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if !common.IsHexAddress(req.Address) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid evm address: %s", req.Address)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	records := k.GetConversionHistory(ctx, common.HexToAddress(req.Address))
+	if req.Limit > 0 && len(records) > int(req.Limit) {
+		records = records[:req.Limit]
+	}
+	return &types.QueryConversionHistoryResponse{Records: records}, nil
+}
@@ -0,0 +1,490 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	cronosmodulekeeper "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *KeeperTestSuite) TestTokenMappingsQuery() {
+	keeper := suite.app.CronosKeeper
+
+	var expected []types.TokenMappingInfo
+	for i := 0; i < 5; i++ {
+		denom := fmt.Sprintf("ibc/%064X", i)
+		contract := common.BigToAddress(common.Big1.Lsh(common.Big1, uint(i+1)))
+		auto := i%2 == 0
+		if auto {
+			keeper.SetAutoContractForDenom(suite.ctx, denom, contract)
+		} else {
+			suite.Require().NoError(keeper.SetExternalContractMapping(suite.ctx, denom, contract))
+		}
+		expected = append(expected, types.TokenMappingInfo{
+			Denom:        denom,
+			Contract:     contract.Hex(),
+			AutoDeployed: auto,
+		})
+	}
+	// the external contract shadows the auto-deployed one
+	shadowed := common.BigToAddress(common.Big3)
+	keeper.SetAutoContractForDenom(suite.ctx, expected[1].Denom, shadowed)
+
+	// source token, sorted before the ibc denoms
+	sourceDenom := "cronos" + common.BigToAddress(common.Big32).Hex()
+	suite.Require().NoError(keeper.SetExternalContractMapping(suite.ctx, sourceDenom, common.BigToAddress(common.Big32)))
+	expected = append([]types.TokenMappingInfo{{
+		Denom:    sourceDenom,
+		Contract: common.BigToAddress(common.Big32).Hex(),
+		IsSource: true,
+	}}, expected...)
+
+	// all in one page
+	rsp, err := keeper.TokenMappings(suite.ctx, &types.QueryTokenMappingsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, rsp.Mappings)
+	suite.Require().Equal(uint64(len(expected)), rsp.Pagination.Total)
+	suite.Require().Nil(rsp.Pagination.NextKey)
+
+	// paginate by key
+	var (
+		all     []types.TokenMappingInfo
+		nextKey []byte
+	)
+	for {
+		rsp, err = keeper.TokenMappings(suite.ctx, &types.QueryTokenMappingsRequest{
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 2},
+		})
+		suite.Require().NoError(err)
+		suite.Require().LessOrEqual(len(rsp.Mappings), 2)
+		all = append(all, rsp.Mappings...)
+		nextKey = rsp.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	suite.Require().Equal(expected, all)
+
+	// paginate by offset
+	rsp, err = keeper.TokenMappings(suite.ctx, &types.QueryTokenMappingsRequest{
+		Pagination: &query.PageRequest{Offset: 4, Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected[4:5], rsp.Mappings)
+	suite.Require().Equal([]byte(expected[5].Denom), rsp.Pagination.NextKey)
+	suite.Require().Equal(uint64(len(expected)), rsp.Pagination.Total)
+
+	// invalid pagination
+	_, err = keeper.TokenMappings(suite.ctx, &types.QueryTokenMappingsRequest{
+		Pagination: &query.PageRequest{Key: []byte(expected[1].Denom), Offset: 1},
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestEscrowBalancesQuery() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+
+	var expected sdk.Coins
+	for i := 0; i < 5; i++ {
+		denom := fmt.Sprintf("ibc/%064X", i)
+		contract := common.BigToAddress(common.Big1.Lsh(common.Big1, uint(i+1)))
+		if i%2 == 0 {
+			keeper.SetAutoContractForDenom(suite.ctx, denom, contract)
+		} else {
+			suite.Require().NoError(keeper.SetExternalContractMapping(suite.ctx, denom, contract))
+		}
+		if i == 2 {
+			// nothing escrowed
+			continue
+		}
+		coin := sdk.NewCoin(denom, sdkmath.NewInt(int64(i+1)*100))
+		suite.Require().NoError(suite.MintCoins(sdk.AccAddress(contract.Bytes()), sdk.NewCoins(coin)))
+		expected = append(expected, coin)
+	}
+	// coins not escrowed by the contract of their mapping are ignored
+	suite.Require().NoError(suite.MintCoins(suite.address.Bytes(), sdk.NewCoins(sdk.NewCoin(expected[0].Denom, sdkmath.NewInt(1)))))
+	// source tokens are not escrowed
+	sourceContract := common.BigToAddress(common.Big32)
+	sourceDenom := "cronos" + sourceContract.Hex()
+	suite.Require().NoError(keeper.SetExternalContractMapping(suite.ctx, sourceDenom, sourceContract))
+	suite.Require().NoError(suite.MintCoins(sdk.AccAddress(sourceContract.Bytes()), sdk.NewCoins(sdk.NewCoin(sourceDenom, sdkmath.NewInt(1)))))
+
+	// all in one page
+	rsp, err := keeper.EscrowBalances(suite.ctx, &types.QueryEscrowBalancesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, rsp.Balances)
+	suite.Require().Equal(uint64(len(expected)), rsp.Pagination.Total)
+	suite.Require().Nil(rsp.Pagination.NextKey)
+
+	// paginate by key
+	var (
+		all     sdk.Coins
+		nextKey []byte
+	)
+	for {
+		rsp, err = keeper.EscrowBalances(suite.ctx, &types.QueryEscrowBalancesRequest{
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 3},
+		})
+		suite.Require().NoError(err)
+		suite.Require().LessOrEqual(len(rsp.Balances), 3)
+		all = append(all, rsp.Balances...)
+		nextKey = rsp.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	suite.Require().Equal(expected, all)
+
+	// paginate by offset
+	rsp, err = keeper.EscrowBalances(suite.ctx, &types.QueryEscrowBalancesRequest{
+		Pagination: &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected[1:3], rsp.Balances)
+	suite.Require().Equal([]byte(expected[3].Denom), rsp.Pagination.NextKey)
+	suite.Require().Equal(uint64(len(expected)), rsp.Pagination.Total)
+
+	// invalid pagination
+	_, err = keeper.EscrowBalances(suite.ctx, &types.QueryEscrowBalancesRequest{
+		Pagination: &query.PageRequest{Reverse: true},
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestSimulateConversionQuery() {
+	denom := "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+
+	testCases := []struct {
+		name      string
+		req       *types.QuerySimulateConversionRequest
+		malleate  func() common.Address
+		expErr    bool
+		expAmount string
+		expAuto   bool
+	}{
+		{
+			"invalid amount",
+			&types.QuerySimulateConversionRequest{Denom: denom, Amount: "-1"},
+			func() common.Address { return common.Address{} },
+			true, "", false,
+		},
+		{
+			"unsupported denom",
+			&types.QuerySimulateConversionRequest{Denom: "test", Amount: "1"},
+			func() common.Address { return common.Address{} },
+			true, "", false,
+		},
+		{
+			"gas token",
+			&types.QuerySimulateConversionRequest{Denom: types.IbcCroDenomDefaultValue, Amount: "123"},
+			func() common.Address { return common.Address{} },
+			false, "1230000000000", false,
+		},
+		{
+			"no mapping, auto-deployment disabled",
+			&types.QuerySimulateConversionRequest{Denom: denom, Amount: "100"},
+			func() common.Address {
+				params := suite.app.CronosKeeper.GetParams(suite.ctx)
+				params.EnableAutoDeployment = false
+				suite.Require().NoError(suite.app.CronosKeeper.SetParams(suite.ctx, params))
+				return common.Address{}
+			},
+			true, "", false,
+		},
+		{
+			"no mapping, auto-deployment enabled",
+			&types.QuerySimulateConversionRequest{Denom: denom, Amount: "100"},
+			func() common.Address {
+				// the predicted address is the one of the next deployment
+				cacheCtx, _ := suite.ctx.CacheContext()
+				contract, err := suite.app.CronosKeeper.DeployModuleCRC21(cacheCtx, denom)
+				suite.Require().NoError(err)
+				return contract
+			},
+			false, "100", true,
+		},
+		{
+			"mapped contract with scaled decimals",
+			&types.QuerySimulateConversionRequest{Denom: denom, Amount: "3000000"},
+			func() common.Address {
+				contract, err := suite.app.CronosKeeper.DeployModuleCRC21(suite.ctx, denom)
+				suite.Require().NoError(err)
+				suite.app.CronosKeeper.SetAutoContractForDenom(suite.ctx, denom, contract)
+				suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+					Base:    denom,
+					Display: "display",
+					DenomUnits: []*banktypes.DenomUnit{
+						{Denom: denom, Exponent: 0},
+						{Denom: "display", Exponent: 6},
+					},
+				})
+				return contract
+			},
+			false, "3", false,
+		},
+		{
+			"conversions paused",
+			&types.QuerySimulateConversionRequest{Denom: denom, Amount: "100"},
+			func() common.Address {
+				params := suite.app.CronosKeeper.GetParams(suite.ctx)
+				params.ConversionPaused = true
+				suite.Require().NoError(suite.app.CronosKeeper.SetParams(suite.ctx, params))
+				return common.Address{}
+			},
+			true, "", false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			contract := tc.malleate()
+			_, mappedBefore := suite.app.CronosKeeper.GetContractByDenom(suite.ctx, denom)
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, types.EVMModuleAddress)
+
+			rsp, err := suite.app.CronosKeeper.SimulateConversion(suite.ctx, tc.req)
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expAmount, rsp.Amount)
+			suite.Require().Equal(tc.expAuto, rsp.AutoDeploy)
+			if contract == (common.Address{}) {
+				suite.Require().Empty(rsp.Contract)
+			} else {
+				suite.Require().Equal(contract.Hex(), rsp.Contract)
+			}
+
+			// nothing is written
+			_, mappedAfter := suite.app.CronosKeeper.GetContractByDenom(suite.ctx, denom)
+			suite.Require().Equal(mappedBefore, mappedAfter)
+			suite.Require().Equal(nonce, suite.app.EvmKeeper.GetNonce(suite.ctx, types.EVMModuleAddress))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSimulateConversionWithFee() {
+	keeper := suite.app.CronosKeeper
+	address := sdk.AccAddress(suite.address.Bytes())
+	params := keeper.GetParams(suite.ctx)
+	params.EnableAutoDeployment = true
+	params.ConversionFees = []types.ConversionFee{{Denom: CorrectIbcDenom, BasisPoints: 1000}}
+	suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+	coin := sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(1000))
+	suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(coin.Add(coin))))
+	msgServer := cronosmodulekeeper.NewMsgServerImpl(keeper)
+
+	// the first conversion deploys the contract, the second one uses the mapped contract
+	expBalance := big.NewInt(0)
+	for _, expAuto := range []bool{true, false} {
+		rsp, err := keeper.SimulateConversion(suite.ctx, &types.QuerySimulateConversionRequest{Denom: coin.Denom, Amount: coin.Amount.String()})
+		suite.Require().NoError(err)
+		suite.Require().Equal(expAuto, rsp.AutoDeploy)
+		suite.Require().Equal(sdk.NewCoin(coin.Denom, sdkmath.NewInt(100)), rsp.Fee)
+		suite.Require().Equal("900", rsp.Amount)
+
+		_, err = msgServer.ConvertVouchers(suite.ctx, types.NewMsgConvertVouchers(address.String(), sdk.NewCoins(coin)))
+		suite.Require().NoError(err)
+		contract, found := keeper.GetContractByDenom(suite.ctx, coin.Denom)
+		suite.Require().True(found)
+		suite.Require().Equal(contract.Hex(), rsp.Contract)
+		ret, err := keeper.CallModuleCRC21(suite.ctx, contract, "balanceOf", common.BytesToAddress(address.Bytes()))
+		suite.Require().NoError(err)
+		amount, ok := new(big.Int).SetString(rsp.Amount, 10)
+		suite.Require().True(ok)
+		expBalance.Add(expBalance, amount)
+		suite.Require().Equal(expBalance, new(big.Int).SetBytes(ret))
+	}
+}
+
+func (suite *KeeperTestSuite) TestDenomDeployInfoQuery() {
+	denom := "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+	autoContract := common.BigToAddress(big.NewInt(1))
+	externalContract := common.BigToAddress(big.NewInt(2))
+
+	testCases := []struct {
+		name     string
+		denom    string
+		malleate func()
+		expErr   bool
+		expRsp   types.QueryDenomDeployInfoResponse
+	}{
+		{
+			"invalid denom",
+			"test",
+			func() {},
+			true,
+			types.QueryDenomDeployInfoResponse{},
+		},
+		{
+			"no mapping, auto-deployment enabled",
+			denom,
+			func() {},
+			false,
+			types.QueryDenomDeployInfoResponse{AutoDeploymentEnabled: true},
+		},
+		{
+			"no mapping, auto-deployment disabled",
+			denom,
+			func() {
+				params := suite.app.CronosKeeper.GetParams(suite.ctx)
+				params.EnableAutoDeployment = false
+				suite.Require().NoError(suite.app.CronosKeeper.SetParams(suite.ctx, params))
+			},
+			false,
+			types.QueryDenomDeployInfoResponse{},
+		},
+		{
+			"auto-deployed contract",
+			denom,
+			func() {
+				suite.app.CronosKeeper.SetAutoContractForDenom(suite.ctx, denom, autoContract)
+			},
+			false,
+			types.QueryDenomDeployInfoResponse{
+				Found: true, Contract: autoContract.Hex(), AutoDeployed: true, AutoDeploymentEnabled: true,
+			},
+		},
+		{
+			"external contract takes precedence",
+			denom,
+			func() {
+				suite.app.CronosKeeper.SetAutoContractForDenom(suite.ctx, denom, autoContract)
+				suite.Require().NoError(suite.app.CronosKeeper.SetExternalContractMapping(suite.ctx, denom, externalContract))
+			},
+			false,
+			types.QueryDenomDeployInfoResponse{
+				Found: true, Contract: externalContract.Hex(), AutoDeploymentEnabled: true,
+			},
+		},
+		{
+			"source denom",
+			"cronos" + autoContract.Hex(),
+			func() {
+				suite.app.CronosKeeper.SetAutoContractForDenom(suite.ctx, "cronos"+autoContract.Hex(), autoContract)
+			},
+			false,
+			types.QueryDenomDeployInfoResponse{
+				Found: true, Contract: autoContract.Hex(), IsSource: true, AutoDeployed: true, AutoDeploymentEnabled: true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			rsp, err := suite.app.CronosKeeper.DenomDeployInfo(suite.ctx, &types.QueryDenomDeployInfoRequest{Denom: tc.denom})
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expRsp, *rsp)
+		})
+	}
+
+	_, err := suite.app.CronosKeeper.DenomDeployInfo(suite.ctx, nil)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestContractsByDenomsQuery() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+	autoDenom := "ibc/0000000000000000000000000000000000000000000000000000000000000001"
+	externalDenom := "ibc/0000000000000000000000000000000000000000000000000000000000000002"
+	missingDenom := "ibc/0000000000000000000000000000000000000000000000000000000000000003"
+	autoContract := common.BigToAddress(big.NewInt(1))
+	externalContract := common.BigToAddress(big.NewInt(2))
+	keeper.SetAutoContractForDenom(suite.ctx, autoDenom, autoContract)
+	keeper.SetAutoContractForDenom(suite.ctx, externalDenom, common.BigToAddress(big.NewInt(3)))
+	suite.Require().NoError(keeper.SetExternalContractMapping(suite.ctx, externalDenom, externalContract))
+
+	// the order of the request is preserved, including the duplicates
+	rsp, err := keeper.ContractsByDenoms(suite.ctx, &types.QueryContractsByDenomsRequest{
+		Denoms: []string{missingDenom, externalDenom, autoDenom, "invalid", externalDenom},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DenomContract{
+		{Denom: missingDenom},
+		{Denom: externalDenom, Found: true, Contract: externalContract.Hex()},
+		{Denom: autoDenom, Found: true, Contract: autoContract.Hex()},
+		{Denom: "invalid"},
+		{Denom: externalDenom, Found: true, Contract: externalContract.Hex()},
+	}, rsp.Contracts)
+
+	// empty list
+	rsp, err = keeper.ContractsByDenoms(suite.ctx, &types.QueryContractsByDenomsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(rsp.Contracts)
+
+	// too many denoms
+	denoms := make([]string, types.MaxContractsByDenomsQuery+1)
+	for i := range denoms {
+		denoms[i] = autoDenom
+	}
+	_, err = keeper.ContractsByDenoms(suite.ctx, &types.QueryContractsByDenomsRequest{Denoms: denoms})
+	suite.Require().Error(err)
+	rsp, err = keeper.ContractsByDenoms(suite.ctx, &types.QueryContractsByDenomsRequest{Denoms: denoms[1:]})
+	suite.Require().NoError(err)
+	suite.Require().Len(rsp.Contracts, types.MaxContractsByDenomsQuery)
+
+	_, err = keeper.ContractsByDenoms(suite.ctx, nil)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestCRC20Queries() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+	address := sdk.AccAddress(suite.address.Bytes())
+	holder := common.BytesToAddress(address.Bytes())
+	coin := sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(100))
+
+	params := keeper.GetParams(suite.ctx)
+	params.EnableAutoDeployment = true
+	suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+	suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(coin)))
+	suite.Require().NoError(keeper.ConvertVouchersToEvmCoins(suite.ctx, address.String(), sdk.NewCoins(coin)))
+	contract, found := keeper.GetContractByDenom(suite.ctx, coin.Denom)
+	suite.Require().True(found)
+
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, types.EVMModuleAddress)
+	rsp, err := keeper.CRC20Balance(suite.ctx, &types.QueryCRC20BalanceRequest{Denom: coin.Denom, Address: holder.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryCRC20BalanceResponse{Contract: contract.Hex(), Balance: "100"}, rsp)
+
+	rsp, err = keeper.CRC20Balance(suite.ctx, &types.QueryCRC20BalanceRequest{Denom: coin.Denom, Address: common.BigToAddress(big.NewInt(0x1000)).Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal("0", rsp.Balance)
+
+	supplyRsp, err := keeper.CRC20Supply(suite.ctx, &types.QueryCRC20SupplyRequest{Denom: coin.Denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryCRC20SupplyResponse{Contract: contract.Hex(), TotalSupply: "100"}, supplyRsp)
+
+	// the queries don't change the state
+	suite.Require().Equal(nonce, suite.app.EvmKeeper.GetNonce(suite.ctx, types.EVMModuleAddress))
+
+	missingDenom := "ibc/0000000000000000000000000000000000000000000000000000000000000001"
+	_, err = keeper.CRC20Balance(suite.ctx, &types.QueryCRC20BalanceRequest{Denom: missingDenom, Address: holder.Hex()})
+	suite.Require().ErrorContains(err, "no contract found for the denom "+missingDenom)
+	_, err = keeper.CRC20Supply(suite.ctx, &types.QueryCRC20SupplyRequest{Denom: missingDenom})
+	suite.Require().ErrorContains(err, "no contract found for the denom "+missingDenom)
+
+	_, err = keeper.CRC20Balance(suite.ctx, &types.QueryCRC20BalanceRequest{Denom: coin.Denom, Address: "invalid"})
+	suite.Require().Error(err)
+	_, err = keeper.CRC20Supply(suite.ctx, &types.QueryCRC20SupplyRequest{Denom: "invalid"})
+	suite.Require().Error(err)
+	_, err = keeper.CRC20Balance(suite.ctx, nil)
+	suite.Require().Error(err)
+	_, err = keeper.CRC20Supply(suite.ctx, nil)
+	suite.Require().Error(err)
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+)
+
+// GetConversionHistory returns the recorded conversions of the evm address, the latest first,
+// it's empty if the conversion history is disabled.
+func (k Keeper) GetConversionHistory(ctx sdk.Context, address common.Address) []types.ConversionRecord {
+	params := k.GetParams(ctx)
+	if !params.EnableConversionHistory {
+		return nil
+	}
+	records := k.getConversionHistory(ctx, address)
+	// the size can be lowered after the records are stored
+	if uint64(len(records)) > params.ConversionHistorySize {
+		records = records[:params.ConversionHistorySize]
+	}
+	return records
+}
+
+func (k Keeper) getConversionHistory(ctx sdk.Context, address common.Address) []types.ConversionRecord {
+	bz := ctx.KVStore(k.storeKey).Get(types.ConversionHistoryKey(address.Bytes()))
+	if bz == nil {
+		return nil
+	}
+	var history types.ConversionHistory
+	k.cdc.MustUnmarshal(bz, &history)
+	return history.Records
+}
+
+// recordConversion prepends the conversion to the history of the evm address if the history is enabled,
+// the records beyond the configured size are pruned.
+func (k Keeper) recordConversion(ctx sdk.Context, address common.Address, denom string, amount sdkmath.Int, direction types.ConversionDirection) {
+	params := k.GetParams(ctx)
+	if !params.EnableConversionHistory {
+		return
+	}
+	records := append([]types.ConversionRecord{{
+		Denom:     denom,
+		Amount:    amount,
+		Height:    ctx.BlockHeight(),
+		Direction: direction,
+	}}, k.getConversionHistory(ctx, address)...)
+	if uint64(len(records)) > params.ConversionHistorySize {
+		records = records[:params.ConversionHistorySize]
+	}
+	bz := k.cdc.MustMarshal(&types.ConversionHistory{Records: records})
+	ctx.KVStore(k.storeKey).Set(types.ConversionHistoryKey(address.Bytes()), bz)
+}
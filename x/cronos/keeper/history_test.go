@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+)
+
+func (suite *KeeperTestSuite) TestConversionHistory() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+	address := sdk.AccAddress(suite.address.Bytes())
+	holder := common.BytesToAddress(address.Bytes())
+	suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(1000)))))
+	query := func(limit uint32) []types.ConversionRecord {
+		rsp, err := keeper.ConversionHistory(suite.ctx, &types.QueryConversionHistoryRequest{Address: holder.Hex(), Limit: limit})
+		suite.Require().NoError(err)
+		return rsp.Records
+	}
+	convert := func(height int64, amount int64) {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		suite.Require().NoError(keeper.ConvertCoinFromNativeToCRC21(suite.ctx, holder, sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(amount)), true))
+	}
+
+	// disabled by default
+	convert(10, 100)
+	suite.Require().Empty(query(0))
+
+	params := keeper.GetParams(suite.ctx)
+	params.EnableConversionHistory = true
+	params.ConversionHistorySize = 3
+	suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+	convert(11, 1)
+	convert(12, 2)
+	contract, found := keeper.GetContractByDenom(suite.ctx, CorrectIbcDenom)
+	suite.Require().True(found)
+	suite.ctx = suite.ctx.WithBlockHeight(13)
+	suite.Require().NoError(keeper.ConvertCoinFromCRC21ToNative(suite.ctx, contract, holder, sdkmath.NewInt(3)))
+
+	record := func(height, amount int64, direction types.ConversionDirection) types.ConversionRecord {
+		return types.ConversionRecord{Denom: CorrectIbcDenom, Amount: sdkmath.NewInt(amount), Height: height, Direction: direction}
+	}
+	suite.Require().Equal([]types.ConversionRecord{
+		record(13, 3, types.CONVERSION_DIRECTION_TO_NATIVE),
+		record(12, 2, types.CONVERSION_DIRECTION_TO_CRC20),
+		record(11, 1, types.CONVERSION_DIRECTION_TO_CRC20),
+	}, query(0))
+	suite.Require().Equal([]types.ConversionRecord{record(13, 3, types.CONVERSION_DIRECTION_TO_NATIVE)}, query(1))
+
+	// the oldest records are pruned
+	convert(14, 4)
+	suite.Require().Equal([]types.ConversionRecord{
+		record(14, 4, types.CONVERSION_DIRECTION_TO_CRC20),
+		record(13, 3, types.CONVERSION_DIRECTION_TO_NATIVE),
+		record(12, 2, types.CONVERSION_DIRECTION_TO_CRC20),
+	}, query(0))
+
+	// lowering the size applies to the stored records
+	params.ConversionHistorySize = 1
+	suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+	suite.Require().Equal([]types.ConversionRecord{record(14, 4, types.CONVERSION_DIRECTION_TO_CRC20)}, query(0))
+
+	// the other addresses have no history
+	rsp, err := keeper.ConversionHistory(suite.ctx, &types.QueryConversionHistoryRequest{Address: common.Address{}.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(rsp.Records)
+
+	// a failed conversion is not recorded
+	suite.Require().Error(keeper.ConvertCoinFromNativeToCRC21(suite.ctx, holder, sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(10000)), true))
+	suite.Require().Equal([]types.ConversionRecord{record(14, 4, types.CONVERSION_DIRECTION_TO_CRC20)}, query(0))
+
+	params.EnableConversionHistory = false
+	suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+	suite.Require().Empty(query(0))
+
+	_, err = keeper.ConversionHistory(suite.ctx, &types.QueryConversionHistoryRequest{Address: "invalid"})
+	suite.Require().Error(err)
+	_, err = keeper.ConversionHistory(suite.ctx, nil)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestConversionHistoryRecipient() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+	address := sdk.AccAddress(suite.address.Bytes())
+	sender := common.BytesToAddress(address.Bytes())
+	recipient := common.BytesToAddress([]byte("history_recipient___"))
+	suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(100)))))
+	params := keeper.GetParams(suite.ctx)
+	params.EnableConversionHistory = true
+	suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+	query := func(holder common.Address) []types.ConversionRecord {
+		rsp, err := keeper.ConversionHistory(suite.ctx, &types.QueryConversionHistoryRequest{Address: holder.Hex()})
+		suite.Require().NoError(err)
+		return rsp.Records
+	}
+
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+	suite.Require().NoError(keeper.ConvertCoinFromNativeToCRC21To(suite.ctx, sender, recipient, sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(100)), true))
+
+	// the conversion is recorded for the recipient of the tokens, like their conversion back
+	suite.Require().Equal([]types.ConversionRecord{{
+		Denom:     CorrectIbcDenom,
+		Amount:    sdkmath.NewInt(100),
+		Height:    10,
+		Direction: types.CONVERSION_DIRECTION_TO_CRC20,
+	}}, query(recipient))
+	suite.Require().Empty(query(sender))
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+)
+
+// SetHooks sets the cronos hooks, it must be called before the keeper is copied to the other modules.
+func (k *Keeper) SetHooks(ch types.CronosHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set cronos hooks twice")
+	}
+	k.hooks = ch
+	return k
+}
+
+// Hooks gets the hooks for the cronos module
+func (k Keeper) Hooks() types.CronosHooks {
+	if k.hooks == nil {
+		// return a no-op implementation if no hooks are set
+		return types.MultiCronosHooks{}
+	}
+	return k.hooks
+}
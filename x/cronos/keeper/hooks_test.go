@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cronosmodulekeeper "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var _ types.CronosHooks = &mockCronosHooks{}
+
+// mockCronosHooks records the conversions it's called with
+type mockCronosHooks struct {
+	err      error
+	vouchers []sdk.Coins
+	coins    []sdk.Coin
+	contract common.Address
+}
+
+func (h *mockCronosHooks) AfterConvertVouchers(_ sdk.Context, _ sdk.AccAddress, _ common.Address, coins sdk.Coins) error {
+	h.vouchers = append(h.vouchers, coins)
+	return h.err
+}
+
+func (h *mockCronosHooks) AfterConvertCoin(_ sdk.Context, _ sdk.AccAddress, contract common.Address, coin sdk.Coin) error {
+	h.coins = append(h.coins, coin)
+	h.contract = contract
+	return h.err
+}
+
+func (suite *KeeperTestSuite) TestCronosHooks() {
+	address := sdk.AccAddress(suite.address.Bytes())
+	coin := sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(100))
+
+	testCases := []struct {
+		name   string
+		hooks  []*mockCronosHooks
+		expErr error
+	}{
+		{"no hooks", nil, nil},
+		{"multiple hooks", []*mockCronosHooks{{}, {}}, nil},
+		{"hook error aborts the conversion", []*mockCronosHooks{{}, {err: errors.New("hook failed")}}, errors.New("hook failed")},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			hooks := make([]types.CronosHooks, len(tc.hooks))
+			for i, h := range tc.hooks {
+				hooks[i] = h
+			}
+			if len(hooks) > 0 {
+				suite.app.CronosKeeper.SetHooks(types.NewMultiCronosHooks(hooks...))
+			}
+			suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(coin.Add(coin))))
+			msgServer := cronosmodulekeeper.NewMsgServerImpl(suite.app.CronosKeeper)
+
+			// the state is reverted by the caller when the message fails
+			ctx, _ := suite.ctx.CacheContext()
+			_, err := msgServer.ConvertVouchers(ctx, types.NewMsgConvertVouchers(address.String(), sdk.NewCoins(coin)))
+			if tc.expErr != nil {
+				suite.Require().EqualError(err, tc.expErr.Error())
+				return
+			}
+			suite.Require().NoError(err)
+			_, err = msgServer.ConvertCoin(ctx, types.NewMsgConvertCoin(address.String(), coin))
+			suite.Require().NoError(err)
+
+			contract, found := suite.app.CronosKeeper.GetContractByDenom(ctx, coin.Denom)
+			suite.Require().True(found)
+			for _, h := range tc.hooks {
+				suite.Require().Equal([]sdk.Coins{sdk.NewCoins(coin)}, h.vouchers)
+				suite.Require().Equal([]sdk.Coin{coin}, h.coins)
+				suite.Require().Equal(contract, h.contract)
+			}
+		})
+	}
+
+	// the hooks are already set by the last case
+	suite.Require().Panics(func() {
+		suite.app.CronosKeeper.SetHooks(types.NewMultiCronosHooks())
+	})
+}
+
+func (suite *KeeperTestSuite) TestAfterConvertVouchersNetOfFees() {
+	address := sdk.AccAddress(suite.address.Bytes())
+	coins := sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(1000)))
+	net := sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(900)))
+
+	testCases := []struct {
+		name    string
+		convert func(ctx sdk.Context) error
+	}{
+		{
+			"MsgConvertVouchers",
+			func(ctx sdk.Context) error {
+				msgServer := cronosmodulekeeper.NewMsgServerImpl(suite.app.CronosKeeper)
+				_, err := msgServer.ConvertVouchers(ctx, types.NewMsgConvertVouchers(address.String(), coins))
+				return err
+			},
+		},
+		{
+			"ConvertAndTransfer",
+			func(ctx sdk.Context) error {
+				_, err := suite.app.CronosKeeper.ConvertAndTransfer(ctx, address.String(), coins, false, "", "", types.IbcTransferOptions{})
+				return err
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			hooks := &mockCronosHooks{}
+			suite.app.CronosKeeper.SetHooks(types.NewMultiCronosHooks(hooks))
+			params := suite.app.CronosKeeper.GetParams(suite.ctx)
+			params.EnableAutoDeployment = true
+			params.ConversionFees = []types.ConversionFee{{Denom: CorrectIbcDenom, BasisPoints: 1000}}
+			suite.Require().NoError(suite.app.CronosKeeper.SetParams(suite.ctx, params))
+			suite.Require().NoError(suite.MintCoins(address, coins))
+
+			suite.Require().NoError(tc.convert(suite.ctx))
+			suite.Require().Equal([]sdk.Coins{net}, hooks.vouchers)
+		})
+	}
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -23,6 +25,44 @@ func (k Keeper) ConvertVouchersToEvmCoins(ctx sdk.Context, from string, coins sd
 	if err != nil {
 		return err
 	}
+	return k.ConvertVouchersToEvmCoinsTo(ctx, from, common.BytesToAddress(acc.Bytes()), coins)
+}
+
+// ConvertVouchersToEvmCoinsTo converts the vouchers of the sender to evm coins of the recipient, the conversions are
+// subject to the blocklist, the minimum amounts and the quotas, no conversion fee is charged.
+func (k Keeper) ConvertVouchersToEvmCoinsTo(ctx sdk.Context, from string, recipient common.Address, coins sdk.Coins) error {
+	if err := k.applyConversionLimits(ctx, coins); err != nil {
+		return err
+	}
+	return k.convertVouchers(ctx, from, recipient, coins)
+}
+
+// applyConversionLimits checks that the coins can be converted and consumes their conversion quotas, all the
+// denoms are checked before any coin is escrowed
+func (k Keeper) applyConversionLimits(ctx sdk.Context, coins sdk.Coins) error {
+	for _, c := range coins {
+		if err := k.CheckDenomConvertible(ctx, c.Denom); err != nil {
+			return err
+		}
+		if err := k.checkMinConversionAmount(ctx, c); err != nil {
+			return err
+		}
+	}
+	for _, c := range coins {
+		if err := k.consumeConversionQuota(ctx, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convertVouchers converts the vouchers of the sender to evm coins of the recipient without checking the
+// conversion params
+func (k Keeper) convertVouchers(ctx sdk.Context, from string, recipient common.Address, coins sdk.Coins) error {
+	acc, err := sdk.AccAddressFromBech32(from)
+	if err != nil {
+		return err
+	}
 
 	params := k.GetParams(ctx)
 	evmParams := k.GetEvmParams(ctx)
@@ -51,13 +91,13 @@ func (k Keeper) ConvertVouchersToEvmCoins(ctx sdk.Context, from string, coins sd
 
 			// Send evm tokens to receiver
 			if err := k.bankKeeper.SendCoinsFromModuleToAccount(
-				ctx, types.ModuleName, acc, sdk.NewCoins(amount18dec),
+				ctx, types.ModuleName, sdk.AccAddress(recipient.Bytes()), sdk.NewCoins(amount18dec),
 			); err != nil {
 				return err
 			}
 
 		default:
-			err := k.ConvertCoinFromNativeToCRC21(ctx, common.BytesToAddress(acc.Bytes()), c, params.EnableAutoDeployment)
+			err := k.ConvertCoinFromNativeToCRC21To(ctx, common.BytesToAddress(acc.Bytes()), recipient, c, params.EnableAutoDeployment)
 			if err != nil {
 				return err
 			}
@@ -65,19 +105,112 @@ func (k Keeper) ConvertVouchersToEvmCoins(ctx sdk.Context, from string, coins sd
 	}
 	defer func() {
 		for _, a := range coins {
+			labels := conversionLabels(a.Denom)
+			telemetry.IncrCounterWithLabels([]string{types.ModuleName, "convert_vouchers", "count"}, 1, labels)
 			if a.Amount.IsInt64() {
 				telemetry.SetGaugeWithLabels(
 					[]string{"tx", "msg", "ConvertVouchersToEvmCoins"},
 					float32(a.Amount.Int64()),
 					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
 				)
+				telemetry.IncrCounterWithLabels(
+					[]string{types.ModuleName, "convert_vouchers", "amount"},
+					float32(a.Amount.Int64()),
+					labels,
+				)
 			}
 		}
 	}()
 	return nil
 }
 
+// ConvertAndTransfer converts the vouchers of the sender to the evm coins of its evm address, then, if transfer is
+// set, sends the converted tokens to the destination through IBC, they're converted back to the vouchers first,
+// net of the conversion fees. The destination and the channels are checked before any coin is converted, and the
+// state is only written if all the steps succeed, the transferred coins are returned.
+func (k Keeper) ConvertAndTransfer(
+	ctx sdk.Context,
+	from string,
+	coins sdk.Coins,
+	transfer bool,
+	destination string,
+	channelId string,
+	opts types.IbcTransferOptions,
+) (sdk.Coins, error) {
+	acc, err := sdk.AccAddressFromBech32(from)
+	if err != nil {
+		return nil, err
+	}
+	evmAddr := common.BytesToAddress(acc.Bytes())
+
+	if transfer {
+		if len(destination) == 0 {
+			return nil, errors.New("to address cannot be empty")
+		}
+		for _, c := range coins {
+			if err := k.checkTransferChannel(ctx, c.Denom, channelId); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.applyConversionLimits(cacheCtx, coins); err != nil {
+		return nil, err
+	}
+	converted, err := k.chargeConversionFees(cacheCtx, acc, coins)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.convertVouchers(cacheCtx, from, evmAddr, converted); err != nil {
+		return nil, err
+	}
+	if err := k.Hooks().AfterConvertVouchers(cacheCtx, acc, evmAddr, converted); err != nil {
+		return nil, err
+	}
+	if !transfer {
+		commit()
+		return nil, nil
+	}
+
+	params := k.GetParams(cacheCtx)
+	evmParams := k.GetEvmParams(cacheCtx)
+	transferred := sdk.NewCoins()
+	for _, c := range converted {
+		if c.Denom == params.IbcCroDenom {
+			// the evm coins are sent as the ibc cro vouchers
+			transferred = transferred.Add(sdk.NewCoin(evmParams.EvmDenom, c.Amount.Mul(sdkmath.NewIntFromBigInt(types.TenPowTen))))
+			continue
+		}
+		contract, found := k.GetContractByDenom(cacheCtx, c.Denom)
+		if !found {
+			return nil, fmt.Errorf("no contract found for the denom %s", c.Denom)
+		}
+		if err := k.ConvertCoinFromCRC21ToNative(cacheCtx, contract, evmAddr, c.Amount); err != nil {
+			return nil, err
+		}
+		transferred = transferred.Add(c)
+	}
+	if err := k.IbcTransferCoinsWithOptions(cacheCtx, from, destination, transferred, channelId, opts); err != nil {
+		return nil, err
+	}
+	commit()
+	return transferred, nil
+}
+
 func (k Keeper) IbcTransferCoins(ctx sdk.Context, from, destination string, coins sdk.Coins, channelId string) error {
+	return k.IbcTransferCoinsWithOptions(ctx, from, destination, coins, channelId, types.IbcTransferOptions{})
+}
+
+// IbcTransferCoinsWithOptions sends the coins to the destination through IBC like IbcTransferCoins,
+// with the timeouts and the memo of the options
+func (k Keeper) IbcTransferCoinsWithOptions(
+	ctx sdk.Context,
+	from, destination string,
+	coins sdk.Coins,
+	channelId string,
+	opts types.IbcTransferOptions,
+) error {
 	acc, err := sdk.AccAddressFromBech32(from)
 	if err != nil {
 		return err
@@ -90,6 +223,25 @@ func (k Keeper) IbcTransferCoins(ctx sdk.Context, from, destination string, coin
 	params := k.GetParams(ctx)
 	evmParams := k.GetEvmParams(ctx)
 
+	// the denoms and the channels are checked before any token is burned or escrowed
+	for _, c := range coins {
+		denom, channel := c.Denom, channelId
+		if denom == evmParams.EvmDenom {
+			// the gas token is sent as the ibc cro vouchers, through the channel of their denom trace
+			denom, channel = params.IbcCroDenom, ""
+		} else {
+			if !types.IsValidIBCDenom(denom) && !types.IsValidCronosDenom(denom) {
+				return fmt.Errorf("the coin %s is neither an ibc voucher or a cronos token", denom)
+			}
+			if _, found := k.GetContractByDenom(ctx, denom); !found {
+				return fmt.Errorf("coin %s is not supported", denom)
+			}
+		}
+		if err := k.checkTransferChannel(ctx, denom, channel); err != nil {
+			return err
+		}
+	}
+
 	for _, c := range coins {
 		switch c.Denom {
 		case evmParams.EvmDenom:
@@ -124,20 +276,13 @@ func (k Keeper) IbcTransferCoins(ctx sdk.Context, from, destination string, coin
 			}
 
 			// No need to specify the channelId because it's not a source token
-			err = k.ibcSendTransfer(ctx, acc, destination, ibcCoin, "")
+			err = k.ibcSendTransfer(ctx, acc, destination, ibcCoin, "", opts)
 			if err != nil {
 				return err
 			}
 
 		default:
-			if !types.IsValidIBCDenom(c.Denom) && !types.IsValidCronosDenom(c.Denom) {
-				return fmt.Errorf("the coin %s is neither an ibc voucher or a cronos token", c.Denom)
-			}
-			_, found := k.GetContractByDenom(ctx, c.Denom)
-			if !found {
-				return fmt.Errorf("coin %s is not supported", c.Denom)
-			}
-			err = k.ibcSendTransfer(ctx, acc, destination, c, channelId)
+			err = k.ibcSendTransfer(ctx, acc, destination, c, channelId, opts)
 			if err != nil {
 				return err
 			}
@@ -146,6 +291,7 @@ func (k Keeper) IbcTransferCoins(ctx sdk.Context, from, destination string, coin
 
 	defer func() {
 		for _, a := range coins {
+			telemetry.IncrCounterWithLabels([]string{types.ModuleName, "ibc_transfer", "count"}, 1, conversionLabels(a.Denom))
 			if a.Amount.IsInt64() {
 				telemetry.SetGaugeWithLabels(
 					[]string{"tx", "msg", "IbcTransferCoins"},
@@ -158,27 +304,28 @@ func (k Keeper) IbcTransferCoins(ctx sdk.Context, from, destination string, coin
 	return nil
 }
 
-func (k Keeper) ibcSendTransfer(ctx sdk.Context, sender sdk.AccAddress, destination string, coin sdk.Coin, channelId string) error {
-	if types.IsSourceCoin(coin.Denom) {
-		if !channeltypes.IsValidChannelID(channelId) {
-			return errors.New("invalid channel id for ibc transfer of source token")
-		}
-	} else {
-		// If it is not source, then coin is a voucher so we can extract the channel id from the denom
-		channelDenom := coin.Denom
-		sourceChannelID, err := k.GetSourceChannelID(ctx, channelDenom)
-		if err != nil {
-			return err
-		}
-		channelId = sourceChannelID
+func (k Keeper) ibcSendTransfer(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	destination string,
+	coin sdk.Coin,
+	channelId string,
+	opts types.IbcTransferOptions,
+) error {
+	channelId, err := k.getTransferChannel(ctx, coin.Denom, channelId)
+	if err != nil {
+		return err
 	}
 
 	// Transfer coins to receiver through IBC
-	// We use current time for timeout timestamp and zero height for timeoutHeight
-	// it means it can never fail by timeout
-	params := k.GetParams(ctx)
-	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + params.IbcTimeout
-	timeoutHeight := ibcclienttypes.ZeroHeight()
+	// Without explicit timeouts, we use current time plus the ibc timeout param for timeout timestamp
+	// and zero height for timeoutHeight
+	timeoutTimestamp := opts.TimeoutTimestamp
+	timeoutHeight := opts.TimeoutHeight
+	if timeoutTimestamp == 0 && timeoutHeight.IsZero() {
+		timeoutTimestamp = uint64(ctx.BlockTime().UnixNano()) + k.GetParams(ctx).IbcTimeout
+		timeoutHeight = ibcclienttypes.ZeroHeight()
+	}
 	msg := ibctransfertypes.MsgTransfer{
 		SourcePort:       ibctransfertypes.PortID,
 		SourceChannel:    channelId,
@@ -187,9 +334,74 @@ func (k Keeper) ibcSendTransfer(ctx sdk.Context, sender sdk.AccAddress, destinat
 		Receiver:         destination,
 		TimeoutHeight:    timeoutHeight,
 		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             opts.Memo,
 	}
 	if _, err := k.transferKeeper.Transfer(ctx, &msg); err != nil {
 		return err
 	}
 	return nil
 }
+
+// checkTransferChannel checks that the channel the denom is transferred through exists and is open
+func (k Keeper) checkTransferChannel(ctx sdk.Context, denom, channelId string) error {
+	channelID, err := k.getTransferChannel(ctx, denom, channelId)
+	if err != nil {
+		return err
+	}
+	return k.checkChannelOpen(ctx, channelID)
+}
+
+// getTransferChannel returns the channel the denom is transferred through, the source tokens are sent through the
+// given channel, while the vouchers are always sent back through the channel they were received from, the given
+// channel is ignored for them.
+func (k Keeper) getTransferChannel(ctx sdk.Context, denom, channelId string) (string, error) {
+	if types.IsSourceCoin(denom) {
+		// the token is originated from cronos, it's sent with its base denom through the given channel
+		if !channeltypes.IsValidChannelID(channelId) {
+			return "", errors.New("invalid channel id for ibc transfer of source token")
+		}
+		return channelId, nil
+	}
+	// If it is not source, then coin is a voucher so we can extract the channel id from the denom,
+	// it must be sent back through the channel it was received from so the transfer module strips
+	// the prefix of the voucher instead of prefixing it again
+	sourceChannelID, err := k.GetSourceChannelID(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+	return sourceChannelID, nil
+}
+
+// checkChannelOpen checks the transfer channel exists and is open
+func (k Keeper) checkChannelOpen(ctx sdk.Context, channelID string) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, ibctransfertypes.PortID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port %s, channel %s", ibctransfertypes.PortID, channelID)
+	}
+	if channel.State != channeltypes.OPEN {
+		return errorsmod.Wrapf(types.ErrChannelNotOpen, "channel %s is in state %s", channelID, channel.State)
+	}
+	return nil
+}
+
+// emitConversionFailedEvent reports the vouchers which are kept unconverted by the receiver or the refunded sender,
+// the event is emitted on the context the failure is swallowed in, so it's committed with the packet.
+func (k Keeper) emitConversionFailedEvent(ctx sdk.Context, coins sdk.Coins, reason error) {
+	for _, c := range coins {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventConversionFailed{
+			Denom:     c.Denom,
+			TraceHash: strings.TrimPrefix(c.Denom, "ibc/"),
+			Reason:    reason.Error(),
+		}); err != nil {
+			k.Logger(ctx).Error("failed to emit the conversion failure event", "denom", c.Denom, "error", err)
+		}
+	}
+}
+
+// conversionLabels returns the telemetry labels of the conversion metrics of a denom
+func conversionLabels(denom string) []metrics.Label {
+	return []metrics.Label{
+		telemetry.NewLabel("denom", denom),
+		telemetry.NewLabel("source", strconv.FormatBool(types.IsSourceCoin(denom))),
+	}
+}
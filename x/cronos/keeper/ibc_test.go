@@ -4,13 +4,23 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	localhost "github.com/cosmos/ibc-go/v8/modules/light-clients/09-localhost"
 	cronosmodulekeeper "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
 	keepertest "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper/mock"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/middleware"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
@@ -296,10 +306,14 @@ func (suite *KeeperTestSuite) TestIbcTransferCoins() {
 				suite.app.EncodingConfig().Codec,
 				suite.app.GetKey(types.StoreKey),
 				suite.app.GetKey(types.MemStoreKey),
+				suite.app.GetObjKey(types.ObjectStoreKey),
 				suite.app.BankKeeper,
 				keepertest.IbcKeeperMock{},
 				suite.app.EvmKeeper,
 				suite.app.AccountKeeper,
+				suite.app.DistrKeeper,
+				suite.app.StakingKeeper,
+				suite.app.IBCKeeper.ChannelKeeper,
 				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 			)
 			suite.app.CronosKeeper = cronosKeeper
@@ -315,3 +329,400 @@ func (suite *KeeperTestSuite) TestIbcTransferCoins() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestIbcTransferUnknownDenomTrace() {
+	suite.SetupTest()
+	// the hash is unknown to the mocked transfer keeper
+	hash := "BBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBB"
+	denom := "ibc/" + hash
+	suite.app.CronosKeeper = *cronosmodulekeeper.NewKeeper(
+		suite.app.EncodingConfig().Codec,
+		suite.app.GetKey(types.StoreKey),
+		suite.app.GetKey(types.MemStoreKey),
+		suite.app.GetObjKey(types.ObjectStoreKey),
+		suite.app.BankKeeper,
+		keepertest.IbcKeeperMock{},
+		suite.app.EvmKeeper,
+		suite.app.AccountKeeper,
+		suite.app.DistrKeeper,
+		suite.app.StakingKeeper,
+		suite.app.IBCKeeper.ChannelKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	address := sdk.AccAddress(suite.address.Bytes())
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100)))
+	suite.app.CronosKeeper.SetAutoContractForDenom(suite.ctx, denom, common.HexToAddress("0x11"))
+	suite.Require().NoError(suite.MintCoins(address, coins))
+
+	err := suite.app.CronosKeeper.IbcTransferCoins(suite.ctx, address.String(), "to", coins, "")
+	suite.Require().ErrorIs(err, types.ErrDenomTraceNotFound)
+	suite.Require().Equal(coins, suite.app.BankKeeper.GetAllBalances(suite.ctx, address))
+}
+
+func (suite *KeeperTestSuite) TestRecvPacketConversionFailedEvent() {
+	suite.SetupTest()
+	srcChannel, dstChannel := suite.OpenLocalhostTransferChannels()
+	sender := sdk.AccAddress([]byte("failed_convert_sendr"))
+	receiver := sdk.AccAddress([]byte("failed_convert_recvr"))
+	coin := sdk.NewCoin("stake", sdkmath.NewInt(123))
+	suite.Require().NoError(suite.MintCoins(sender, sdk.NewCoins(coin)))
+
+	// the received vouchers are below the minimum conversion amount
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, dstChannel, coin.Denom)).IBCDenom()
+	params := suite.app.CronosKeeper.GetParams(suite.ctx)
+	params.IbcCroDenom = voucher
+	params.MinConversionAmounts = []types.ConversionMinimum{{Denom: voucher, Amount: sdkmath.NewInt(1000)}}
+	suite.Require().NoError(suite.app.CronosKeeper.SetParams(suite.ctx, params))
+
+	timeout := uint64(suite.ctx.BlockTime().Add(time.Hour).UnixNano())
+	res, err := suite.app.TransferKeeper.Transfer(suite.ctx, transfertypes.NewMsgTransfer(
+		transfertypes.PortID, srcChannel, coin, sender.String(), receiver.String(), clienttypes.ZeroHeight(), timeout, "",
+	))
+	suite.Require().NoError(err)
+	data := transfertypes.NewFungibleTokenPacketData(coin.Denom, coin.Amount.String(), sender.String(), receiver.String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), res.Sequence, transfertypes.PortID, srcChannel, transfertypes.PortID, dstChannel, clienttypes.ZeroHeight(), timeout)
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.RecvLocalhostPacket(packet)
+	suite.Require().NoError(err)
+
+	// the packet succeeds, the receiver keeps the vouchers
+	ack, found := suite.app.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(suite.ctx, transfertypes.PortID, dstChannel, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()), ack)
+	suite.Require().Equal(coin.Amount, suite.GetBalance(receiver, voucher).Amount)
+	suite.Require().True(suite.GetBalance(receiver, suite.evmParam.EvmDenom).IsZero())
+
+	// the failure is reported in the events of the relay
+	var events []proto.Message
+	for _, event := range suite.ctx.EventManager().ABCIEvents() {
+		if event.Type != "cronos.EventConversionFailed" {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		suite.Require().NoError(err)
+		events = append(events, msg)
+	}
+	suite.Require().Len(events, 1)
+	failed := events[0].(*types.EventConversionFailed)
+	suite.Require().Equal(voucher, failed.Denom)
+	suite.Require().Equal(strings.TrimPrefix(voucher, "ibc/"), failed.TraceHash)
+	suite.Require().Contains(failed.Reason, types.ErrAmountTooSmall.Error())
+}
+
+func (suite *KeeperTestSuite) TestOnRefundVouchers() {
+	suite.SetupTest()
+	privKey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	address := sdk.AccAddress(privKey.PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewCoin(types.IbcCroDenomDefaultValue, sdkmath.NewInt(123)))
+	packet := channeltypes.NewPacket(nil, 7, "transfer", "channel-0", "transfer", "channel-1", clienttypes.ZeroHeight(), 0)
+
+	suite.MintCoins(address, coins)
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.app.CronosKeeper.OnRefundVouchers(ctx, packet, coins, address.String())
+	suite.Require().Equal(sdkmath.NewInt(0), suite.GetBalance(address, types.IbcCroDenomDefaultValue).Amount)
+	suite.Require().Equal(sdkmath.NewInt(1230000000000), suite.GetBalance(address, suite.evmParam.EvmDenom).Amount)
+
+	var refundEvents int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeRefundVouchers {
+			continue
+		}
+		refundEvents++
+		attrs := map[string]string{}
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		suite.Require().Equal(address.String(), attrs[types.AttributeKeySender])
+		suite.Require().Equal("channel-0", attrs[types.AttributeKeyPacketChannel])
+		suite.Require().Equal("7", attrs[types.AttributeKeyPacketSequence])
+		suite.Require().Equal(coins.String(), attrs[sdk.AttributeKeyAmount])
+	}
+	suite.Require().Equal(1, refundEvents)
+
+	// another packet on the same channel is processed
+	suite.MintCoins(address, coins)
+	packet.Sequence = 8
+	suite.app.CronosKeeper.OnRefundVouchers(suite.ctx, packet, coins, address.String())
+	suite.Require().Equal(sdkmath.NewInt(0), suite.GetBalance(address, types.IbcCroDenomDefaultValue).Amount)
+	suite.Require().Equal(sdkmath.NewInt(2460000000000), suite.GetBalance(address, suite.evmParam.EvmDenom).Amount)
+}
+
+// mockTransferModule credits the vouchers of the received packets like the transfer module
+type mockTransferModule struct {
+	porttypes.IBCModule
+	suite    *KeeperTestSuite
+	received int
+}
+
+func (m *mockTransferModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	m.suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	m.suite.Require().True(ok)
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.DestinationPort, packet.DestinationChannel, data.Denom))
+	coins := sdk.NewCoins(sdk.NewCoin(voucher.IBCDenom(), amount))
+	m.suite.Require().NoError(m.suite.app.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	m.suite.Require().NoError(m.suite.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(data.Receiver), coins))
+	m.received++
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func (suite *KeeperTestSuite) TestRecvPacketForwarded() {
+	receiver := sdk.AccAddress([]byte("forward_packet_recvr"))
+	voucher := transfertypes.ParseDenomTrace("transfer/channel-0/basecro").IBCDenom()
+
+	testCases := []struct {
+		name      string
+		memo      string
+		converted bool
+	}{
+		{
+			"forwarded packet",
+			`{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1"}}`,
+			false,
+		},
+		{
+			"forwarded packet with nested cronos options",
+			`{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","next":{"cronos":{"auto_convert":true}}}}`,
+			false,
+		},
+		{
+			// the memo of the final hop is the one nested in the forward directive of the previous hop
+			"final hop of a forwarded packet",
+			`{"cronos":{"auto_convert":true}}`,
+			true,
+		},
+		{
+			// the malformed options are ignored, the vouchers get the conversion of the packets without a memo
+			"invalid cronos options",
+			`{"cronos":{"auto_convert":"yes"}}`,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			params := suite.app.CronosKeeper.GetParams(suite.ctx)
+			params.IbcCroDenom = voucher
+			suite.Require().NoError(suite.app.CronosKeeper.SetParams(suite.ctx, params))
+
+			data := transfertypes.NewFungibleTokenPacketData("basecro", "123", "sender", receiver.String(), tc.memo)
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-1", "transfer", "channel-0", clienttypes.ZeroHeight(), 0)
+			module := middleware.NewIBCConversionModule(&mockTransferModule{suite: suite}, suite.app.CronosKeeper)
+			ack := module.OnRecvPacket(suite.ctx, packet, nil)
+			suite.Require().True(ack.Success())
+
+			if tc.converted {
+				suite.Require().True(suite.GetBalance(receiver, voucher).IsZero())
+				suite.Require().Equal(sdkmath.NewInt(1230000000000), suite.GetBalance(receiver, suite.evmParam.EvmDenom).Amount)
+			} else {
+				// the vouchers are left to the packet forward middleware
+				suite.Require().Equal(sdkmath.NewInt(123), suite.GetBalance(receiver, voucher).Amount)
+				suite.Require().True(suite.GetBalance(receiver, suite.evmParam.EvmDenom).IsZero())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRecvPacketForwardedByMiddleware() {
+	suite.SetupTest()
+	srcChannel, dstChannel := suite.OpenLocalhostTransferChannels()
+	sender := sdk.AccAddress([]byte("forward_packet_sendr"))
+	receiver := sdk.AccAddress([]byte("forward_packet_recvr"))
+	coin := sdk.NewCoin("stake", sdkmath.NewInt(123))
+	suite.Require().NoError(suite.MintCoins(sender, sdk.NewCoins(coin)))
+
+	// the vouchers of the packet would be converted if it terminated on cronos
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, dstChannel, coin.Denom)).IBCDenom()
+	params := suite.app.CronosKeeper.GetParams(suite.ctx)
+	params.IbcCroDenom = voucher
+	suite.Require().NoError(suite.app.CronosKeeper.SetParams(suite.ctx, params))
+
+	// the packet is forwarded back to the sending channel
+	memo := fmt.Sprintf(`{"forward":{"receiver":"%s","port":"transfer","channel":"%s","next":{"cronos":{"auto_convert":true}}}}`, receiver, dstChannel)
+	timeout := uint64(suite.ctx.BlockTime().Add(time.Hour).UnixNano())
+	res, err := suite.app.TransferKeeper.Transfer(suite.ctx, transfertypes.NewMsgTransfer(
+		transfertypes.PortID, srcChannel, coin, sender.String(), "intermediate", clienttypes.ZeroHeight(), timeout, memo,
+	))
+	suite.Require().NoError(err)
+	data := transfertypes.NewFungibleTokenPacketData(coin.Denom, coin.Amount.String(), sender.String(), "intermediate", memo)
+	packet := channeltypes.NewPacket(data.GetBytes(), res.Sequence, transfertypes.PortID, srcChannel, transfertypes.PortID, dstChannel, clienttypes.ZeroHeight(), timeout)
+
+	_, err = suite.RecvLocalhostPacket(packet)
+	suite.Require().NoError(err)
+
+	// the acknowledgement is written once the next hop acknowledges the forwarded packet
+	_, found := suite.app.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(suite.ctx, transfertypes.PortID, dstChannel, packet.Sequence)
+	suite.Require().False(found)
+	seq, found := suite.app.IBCKeeper.ChannelKeeper.GetNextSequenceSend(suite.ctx, transfertypes.PortID, dstChannel)
+	suite.Require().True(found)
+	suite.Require().NotNil(suite.app.IBCKeeper.ChannelKeeper.GetPacketCommitment(suite.ctx, transfertypes.PortID, dstChannel, seq-1))
+
+	// the vouchers are forwarded instead of being converted
+	forwarded := suite.app.BankKeeper.GetSupply(suite.ctx, voucher)
+	suite.Require().True(forwarded.IsZero())
+	suite.Require().True(suite.GetBalance(receiver, suite.evmParam.EvmDenom).IsZero())
+	suite.Require().True(suite.app.BankKeeper.GetSupply(suite.ctx, suite.evmParam.EvmDenom).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestRecvPacketRelayedTwice() {
+	suite.SetupTest()
+	srcChannel, dstChannel := suite.OpenLocalhostTransferChannels()
+	sender := sdk.AccAddress([]byte("replay_packet_sender"))
+	receiver := sdk.AccAddress([]byte("replay_packet_recvr_"))
+	coin := sdk.NewCoin("stake", sdkmath.NewInt(123))
+	suite.Require().NoError(suite.MintCoins(sender, sdk.NewCoins(coin)))
+
+	// the received vouchers are converted to the gas token
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, dstChannel, coin.Denom)).IBCDenom()
+	params := suite.app.CronosKeeper.GetParams(suite.ctx)
+	params.IbcCroDenom = voucher
+	suite.Require().NoError(suite.app.CronosKeeper.SetParams(suite.ctx, params))
+
+	timeout := uint64(suite.ctx.BlockTime().Add(time.Hour).UnixNano())
+	res, err := suite.app.TransferKeeper.Transfer(suite.ctx, transfertypes.NewMsgTransfer(
+		transfertypes.PortID, srcChannel, coin, sender.String(), receiver.String(), clienttypes.ZeroHeight(), timeout, "",
+	))
+	suite.Require().NoError(err)
+	data := transfertypes.NewFungibleTokenPacketData(coin.Denom, coin.Amount.String(), sender.String(), receiver.String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), res.Sequence, transfertypes.PortID, srcChannel, transfertypes.PortID, dstChannel, clienttypes.ZeroHeight(), timeout)
+
+	rsp, err := suite.RecvLocalhostPacket(packet)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.SUCCESS, rsp.Result)
+	converted := sdk.NewCoin(suite.evmParam.EvmDenom, coin.Amount.Mul(sdkmath.NewIntFromBigInt(types.TenPowTen)))
+	suite.Require().Equal(converted, suite.GetBalance(receiver, suite.evmParam.EvmDenom))
+	suite.Require().True(suite.GetBalance(receiver, voucher).IsZero())
+
+	// the packet receipt rejects the second relay before the callbacks
+	_, chanCap, err := suite.app.IBCKeeper.ChannelKeeper.LookupModuleByChannel(suite.ctx, transfertypes.PortID, dstChannel)
+	suite.Require().NoError(err)
+	err = suite.app.IBCKeeper.ChannelKeeper.RecvPacket(suite.ctx, chanCap, packet, localhost.SentinelProof, clienttypes.GetSelfHeight(suite.ctx))
+	suite.Require().ErrorIs(err, channeltypes.ErrNoOpMsg)
+	rsp, err = suite.RecvLocalhostPacket(packet)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NOOP, rsp.Result)
+
+	// the vouchers are minted and converted once
+	suite.Require().Equal(converted, suite.GetBalance(receiver, suite.evmParam.EvmDenom))
+	suite.Require().Equal(converted, suite.app.BankKeeper.GetSupply(suite.ctx, suite.evmParam.EvmDenom))
+	suite.Require().Equal(coin.Amount, suite.app.BankKeeper.GetSupply(suite.ctx, voucher).Amount)
+}
+
+func (suite *KeeperTestSuite) TestOnRecvVouchersWithMemo() {
+	receiver := sdk.AccAddress([]byte("memo_voucher_receivr"))
+	recipient := common.BytesToAddress([]byte("memo_evm_recipient__"))
+	coins := sdk.NewCoins(sdk.NewCoin(types.IbcCroDenomDefaultValue, sdkmath.NewInt(123)))
+
+	testCases := []struct {
+		name      string
+		coins     sdk.Coins
+		opts      *types.AutoConvertOptions
+		malleate  func()
+		expectErr bool
+		postCheck func()
+	}{
+		{
+			"convert to the receiver",
+			coins,
+			&types.AutoConvertOptions{AutoConvert: true},
+			func() {},
+			false,
+			func() {
+				suite.Require().True(suite.GetBalance(receiver, types.IbcCroDenomDefaultValue).IsZero())
+				suite.Require().Equal(sdkmath.NewInt(1230000000000), suite.GetBalance(receiver, suite.evmParam.EvmDenom).Amount)
+			},
+		},
+		{
+			"convert to the recipient",
+			coins,
+			&types.AutoConvertOptions{AutoConvert: true, Recipient: recipient.Hex()},
+			func() {},
+			false,
+			func() {
+				suite.Require().True(suite.GetBalance(receiver, types.IbcCroDenomDefaultValue).IsZero())
+				suite.Require().True(suite.GetBalance(receiver, suite.evmParam.EvmDenom).IsZero())
+				suite.Require().Equal(sdkmath.NewInt(1230000000000), suite.GetBalance(sdk.AccAddress(recipient.Bytes()), suite.evmParam.EvmDenom).Amount)
+			},
+		},
+		{
+			"fallback when the denom can't be converted",
+			sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(123))),
+			&types.AutoConvertOptions{AutoConvert: true, Recipient: recipient.Hex()},
+			func() {
+				params := suite.app.CronosKeeper.GetParams(suite.ctx)
+				params.EnableAutoDeployment = false
+				suite.Require().NoError(suite.app.CronosKeeper.SetParams(suite.ctx, params))
+			},
+			true,
+			func() {
+				// vouchers are kept by the receiver
+				suite.Require().Equal(sdkmath.NewInt(123), suite.GetBalance(receiver, CorrectIbcDenom).Amount)
+				suite.Require().True(suite.GetBalance(sdk.AccAddress(recipient.Bytes()), CorrectIbcDenom).IsZero())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+			suite.Require().NoError(suite.MintCoins(receiver, tc.coins))
+			err := suite.app.CronosKeeper.OnRecvVouchersWithMemo(suite.ctx, tc.coins, receiver.String(), tc.opts)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+			tc.postCheck()
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAutoDeployAllowlist() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+	address := sdk.AccAddress([]byte("allowlist_voucher___"))
+	allowed := sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(100))
+	denied := sdk.NewCoin("ibc/BBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBB", sdkmath.NewInt(100))
+	registered := sdk.NewCoin("ibc/CCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCC", sdkmath.NewInt(100))
+
+	params := keeper.GetParams(suite.ctx)
+	params.EnableAutoDeployment = true
+	params.AutoDeployAllowlist = []string{allowed.Denom}
+	suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+
+	// the denoms out of the allowlist are not auto-deployed
+	suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(denied)))
+	err := keeper.ConvertVouchersToEvmCoins(suite.ctx, address.String(), sdk.NewCoins(denied))
+	suite.Require().ErrorIs(err, types.ErrDenomNotAllowed)
+	_, found := keeper.GetContractByDenom(suite.ctx, denied.Denom)
+	suite.Require().False(found)
+
+	// the received vouchers are left in the bank balance
+	keeper.OnRecvVouchers(suite.ctx, sdk.NewCoins(denied), address.String())
+	suite.Require().Equal(denied, suite.GetBalance(address, denied.Denom))
+
+	// the contracts registered by the admins are still used
+	contract, err := keeper.DeployModuleCRC21(suite.ctx, registered.Denom)
+	suite.Require().NoError(err)
+	suite.Require().NoError(keeper.SetExternalContractMapping(suite.ctx, registered.Denom, contract))
+	suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(registered)))
+	suite.Require().NoError(keeper.ConvertVouchersToEvmCoins(suite.ctx, address.String(), sdk.NewCoins(registered)))
+	suite.Require().True(suite.GetBalance(address, registered.Denom).IsZero())
+
+	// the allowed denoms are auto-deployed
+	suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(allowed)))
+	suite.Require().NoError(keeper.ConvertVouchersToEvmCoins(suite.ctx, address.String(), sdk.NewCoins(allowed)))
+	_, found = keeper.GetContractByDenom(suite.ctx, allowed.Denom)
+	suite.Require().True(found)
+
+	// any denom is auto-deployed without an allowlist
+	params.AutoDeployAllowlist = nil
+	suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+	suite.Require().NoError(keeper.ConvertVouchersToEvmCoins(suite.ctx, address.String(), sdk.NewCoins(denied)))
+	_, found = keeper.GetContractByDenom(suite.ctx, denied.Denom)
+	suite.Require().True(found)
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+)
+
+// RegisterInvariants registers the cronos module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "escrow-supply", EscrowSupplyInvariant(k))
+}
+
+// EscrowSupplyInvariant checks that the total supply of each auto-deployed contract is backed by the coins it
+// escrows. The escrow can exceed the supply, since anyone can send coins to the address of a contract. Source
+// tokens are not escrowed and external contracts can be minted outside of the module, so they are not checked.
+func EscrowSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var mappings []types.TokenMapping
+		k.IterateTokenMappings(ctx, nil, func(mapping types.TokenMapping, auto bool) bool {
+			if auto && !types.IsSourceCoin(mapping.Denom) {
+				mappings = append(mappings, mapping)
+			}
+			return false
+		})
+
+		var (
+			msg    string
+			broken int
+		)
+		for _, mapping := range mappings {
+			contract := common.HexToAddress(mapping.Contract)
+			ret, err := k.CallModuleCRC21(ctx, contract, "totalSupply")
+			if err != nil {
+				broken++
+				msg += fmt.Sprintf("\tdenom %s: %s\n", mapping.Denom, err)
+				continue
+			}
+			// the supply is compared in native units, the escrow may hold amounts which can't be scaled
+			supply, err := k.ScaleToNativeAmount(ctx, mapping.Denom, contract, new(big.Int).SetBytes(ret))
+			if err != nil {
+				broken++
+				msg += fmt.Sprintf("\tdenom %s: %s\n", mapping.Denom, err)
+				continue
+			}
+			escrowed := k.bankKeeper.SpendableCoins(ctx, sdk.AccAddress(contract.Bytes())).AmountOf(mapping.Denom).BigInt()
+			if supply.Cmp(escrowed) > 0 {
+				broken++
+				msg += fmt.Sprintf("\tdenom %s: escrowed %s, contract %s total supply %s, diff %s\n",
+					mapping.Denom, escrowed, contract.Hex(), supply, new(big.Int).Sub(supply, escrowed))
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "escrow-supply",
+			fmt.Sprintf("found %d under-collateralised contracts\n%s", broken, msg)), broken != 0
+	}
+}
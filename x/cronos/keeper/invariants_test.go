@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cronosmodulekeeper "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
+)
+
+func (suite *KeeperTestSuite) TestEscrowSupplyInvariant() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+	invariant := cronosmodulekeeper.EscrowSupplyInvariant(keeper)
+	address := common.BytesToAddress(suite.address.Bytes())
+
+	// no mappings
+	_, broken := invariant(suite.ctx)
+	suite.Require().False(broken)
+
+	// auto-deployed contract backed by the escrowed coins
+	coins := sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(100)))
+	suite.Require().NoError(suite.MintCoins(sdk.AccAddress(address.Bytes()), coins))
+	suite.Require().NoError(keeper.ConvertCoinsFromNativeToCRC21(suite.ctx, address, coins, true))
+	_, broken = invariant(suite.ctx)
+	suite.Require().False(broken)
+
+	// coins sent to the contract without minting
+	contract, found := keeper.GetContractByDenom(suite.ctx, CorrectIbcDenom)
+	suite.Require().True(found)
+	extra := sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(5)))
+	suite.Require().NoError(suite.MintCoins(sdk.AccAddress(address.Bytes()), extra))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoins(suite.ctx, sdk.AccAddress(address.Bytes()), sdk.AccAddress(contract.Bytes()), extra))
+	_, broken = invariant(suite.ctx)
+	suite.Require().False(broken)
+
+	// minted without escrow, beyond the extra coins
+	_, err := keeper.CallModuleCRC21(suite.ctx, contract, "mint_by_cronos_module", address, big.NewInt(6))
+	suite.Require().NoError(err)
+	msg, broken := invariant(suite.ctx)
+	suite.Require().True(broken)
+	suite.Require().Contains(msg, CorrectIbcDenom)
+	suite.Require().Contains(msg, "diff 1")
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
 	"strings"
@@ -30,6 +31,8 @@ type (
 		cdc      codec.Codec
 		storeKey storetypes.StoreKey
 		memKey   storetypes.StoreKey
+		// object store used to cache the denom traces during a block
+		objStoreKey storetypes.StoreKey
 
 		// update balance and accounting operations with coins
 		bankKeeper types.BankKeeper
@@ -39,11 +42,20 @@ type (
 		evmKeeper types.EvmKeeper
 		// account keeper
 		accountKeeper types.AccountKeeper
+		// distribution keeper, funding the community pool with the conversion fees
+		distributionKeeper types.DistributionKeeper
+		// staking keeper, the bond denom can't be converted
+		stakingKeeper types.StakingKeeper
+		// ibc channel keeper, checking the channels of the transfers
+		channelKeeper types.ChannelKeeper
 
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
 		authority string
 
+		// hooks called after the conversions
+		hooks types.CronosHooks
+
 		// this line is used by starport scaffolding # ibc/keeper/attribute
 	}
 )
@@ -52,10 +64,14 @@ func NewKeeper(
 	cdc codec.Codec,
 	storeKey,
 	memKey storetypes.StoreKey,
+	objStoreKey storetypes.StoreKey,
 	bankKeeper types.BankKeeper,
 	transferKeeper types.TransferKeeper,
 	evmKeeper types.EvmKeeper,
 	accountKeeper types.AccountKeeper,
+	distributionKeeper types.DistributionKeeper,
+	stakingKeeper types.StakingKeeper,
+	channelKeeper types.ChannelKeeper,
 	authority string,
 	// this line is used by starport scaffolding # ibc/keeper/parameter
 ) *Keeper {
@@ -64,14 +80,18 @@ func NewKeeper(
 	}
 
 	return &Keeper{
-		cdc:            cdc,
-		storeKey:       storeKey,
-		memKey:         memKey,
-		bankKeeper:     bankKeeper,
-		transferKeeper: transferKeeper,
-		evmKeeper:      evmKeeper,
-		accountKeeper:  accountKeeper,
-		authority:      authority,
+		cdc:                cdc,
+		storeKey:           storeKey,
+		memKey:             memKey,
+		objStoreKey:        objStoreKey,
+		bankKeeper:         bankKeeper,
+		transferKeeper:     transferKeeper,
+		evmKeeper:          evmKeeper,
+		accountKeeper:      accountKeeper,
+		distributionKeeper: distributionKeeper,
+		stakingKeeper:      stakingKeeper,
+		channelKeeper:      channelKeeper,
+		authority:          authority,
 		// this line is used by starport scaffolding # ibc/keeper/return
 	}
 }
@@ -128,8 +148,24 @@ func (k Keeper) GetDenomByContract(ctx sdk.Context, contract common.Address) (de
 	return string(bz), true
 }
 
-// SetExternalContractForDenom set the external contract for native denom, replace the old one if any existing.
-func (k Keeper) SetExternalContractForDenom(ctx sdk.Context, denom string, address common.Address) error {
+// SetExternalContractForDenom registers an already deployed contract as the external contract of the native denom,
+// replacing the old one if any existing, it applies the same validations as MsgUpdateTokenMapping: the address must
+// have a valid EIP-55 checksum if it's mixed-case and there must be code deployed at it.
+// The external contract takes precedence over the auto-deployed one and the reverse index is updated.
+func (k Keeper) SetExternalContractForDenom(ctx sdk.Context, denom, address string) error {
+	if !types.IsValidCoinDenom(denom) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom format (%s)", denom)
+	}
+	contract, err := k.validateMappedContract(ctx, address)
+	if err != nil {
+		return err
+	}
+	return k.SetExternalContractMapping(ctx, denom, contract)
+}
+
+// SetExternalContractMapping set the external contract for native denom, replace the old one if any existing,
+// the contract is not validated, it's used to import the genesis state.
+func (k Keeper) SetExternalContractMapping(ctx sdk.Context, denom string, address common.Address) error {
 	// check the contract is not registered already
 	_, found := k.GetDenomByContract(ctx, address)
 	if found {
@@ -151,6 +187,7 @@ func (k Keeper) SetExternalContractForDenom(ctx sdk.Context, denom string, addre
 func (k Keeper) GetExternalContracts(ctx sdk.Context) (out []types.TokenMapping) {
 	store := ctx.KVStore(k.storeKey)
 	iter := prefix.NewStore(store, types.KeyPrefixDenomToExternalContract).Iterator(nil, nil)
+	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 		out = append(out, types.TokenMapping{
 			Denom:    string(iter.Key()),
@@ -164,6 +201,7 @@ func (k Keeper) GetExternalContracts(ctx sdk.Context) (out []types.TokenMapping)
 func (k Keeper) GetAutoContracts(ctx sdk.Context) (out []types.TokenMapping) {
 	store := ctx.KVStore(k.storeKey)
 	iter := prefix.NewStore(store, types.KeyPrefixDenomToAutoContract).Iterator(nil, nil)
+	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 		out = append(out, types.TokenMapping{
 			Denom:    string(iter.Key()),
@@ -173,6 +211,50 @@ func (k Keeper) GetAutoContracts(ctx sdk.Context) (out []types.TokenMapping) {
 	return
 }
 
+// IterateTokenMappings iterates the token mappings in the order of denom, starting from the denom `start`
+// (inclusive), the external contract is taken in preference to the auto-deployed one if both exist,
+// the iteration stops when the callback returns true.
+func (k Keeper) IterateTokenMappings(ctx sdk.Context, start []byte, cb func(mapping types.TokenMapping, auto bool) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	externalIter := prefix.NewStore(store, types.KeyPrefixDenomToExternalContract).Iterator(start, nil)
+	defer externalIter.Close()
+	autoIter := prefix.NewStore(store, types.KeyPrefixDenomToAutoContract).Iterator(start, nil)
+	defer autoIter.Close()
+
+	for externalIter.Valid() || autoIter.Valid() {
+		var (
+			iter storetypes.Iterator
+			auto bool
+		)
+		switch {
+		case !autoIter.Valid():
+			iter = externalIter
+		case !externalIter.Valid():
+			iter, auto = autoIter, true
+		default:
+			switch bytes.Compare(externalIter.Key(), autoIter.Key()) {
+			case 0:
+				// shadowed by the external contract
+				autoIter.Next()
+				iter = externalIter
+			case -1:
+				iter = externalIter
+			default:
+				iter, auto = autoIter, true
+			}
+		}
+
+		mapping := types.TokenMapping{
+			Denom:    string(iter.Key()),
+			Contract: common.BytesToAddress(iter.Value()).Hex(),
+		}
+		iter.Next()
+		if cb(mapping, auto) {
+			return
+		}
+	}
+}
+
 // DeleteExternalContractForDenom delete the external contract mapping for native denom,
 // returns false if mapping not exists.
 func (k Keeper) DeleteExternalContractForDenom(ctx sdk.Context, denom string) bool {
@@ -186,9 +268,51 @@ func (k Keeper) DeleteExternalContractForDenom(ctx sdk.Context, denom string) bo
 	return true
 }
 
+// deleteAutoContractForDenom delete the auto deployed contract mapping for native denom,
+// returns false if mapping not exists.
+func (k Keeper) deleteAutoContractForDenom(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	contract, found := k.getAutoContractByDenom(ctx, denom)
+	if !found {
+		return false
+	}
+	store.Delete(types.DenomToAutoContractKey(denom))
+	store.Delete(types.ContractToDenomKey(contract.Bytes()))
+	return true
+}
+
+// DeleteTokenMapping delete the mapping of the denom, the external contract takes precedence over the auto deployed
+// one like in GetContractByDenom. It fails if the contract still has outstanding tokens, otherwise they couldn't be
+// converted back, the coins sent to the contract address without a conversion don't prevent it.
+func (k Keeper) DeleteTokenMapping(ctx sdk.Context, denom string) error {
+	contract, found := k.GetContractByDenom(ctx, denom)
+	if !found {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "no contract found for the denom %s", denom)
+	}
+	ret, err := k.CallModuleCRC21(ctx, contract, "totalSupply")
+	if err != nil {
+		return err
+	}
+	if supply := new(big.Int).SetBytes(ret); supply.Sign() != 0 {
+		return errors.Wrapf(types.ErrSupplyNotEmpty, "contract %s has a total supply of %s", contract.Hex(), supply)
+	}
+	if !k.DeleteExternalContractForDenom(ctx, denom) {
+		k.deleteAutoContractForDenom(ctx, denom)
+	}
+	return ctx.EventManager().EmitTypedEvent(&types.EventDeleteTokenMapping{
+		Denom:    denom,
+		Contract: contract.Hex(),
+	})
+}
+
 // SetAutoContractForDenom set the auto deployed contract for native denom
 func (k Keeper) SetAutoContractForDenom(ctx sdk.Context, denom string, address common.Address) {
 	store := ctx.KVStore(k.storeKey)
+	existing, found := k.getAutoContractByDenom(ctx, denom)
+	if found && existing != address {
+		// remove the stale reverse index
+		store.Delete(types.ContractToDenomKey(existing.Bytes()))
+	}
 	store.Set(types.DenomToAutoContractKey(denom), address.Bytes())
 	store.Set(types.ContractToDenomKey(address.Bytes()), []byte(denom))
 }
@@ -207,7 +331,62 @@ func (k Keeper) OnRecvVouchers(
 		k.Logger(ctx).Error(
 			fmt.Sprintf("Failed to convert vouchers to evm tokens for receiver %s, coins %s. Receive error %s",
 				receiver, tokens.String(), err))
+		k.emitConversionFailedEvent(ctx, tokens, err)
+	}
+}
+
+// OnRecvVouchersWithMemo try to convert the received vouchers to evm coins of the recipient requested in the
+// transfer memo, the vouchers are kept by the receiver in case of failure.
+func (k Keeper) OnRecvVouchersWithMemo(
+	ctx sdk.Context,
+	tokens sdk.Coins,
+	receiver string,
+	opts *types.AutoConvertOptions,
+) error {
+	receiverAcc, err := sdk.AccAddressFromBech32(receiver)
+	if err != nil {
+		return err
+	}
+	recipient := common.BytesToAddress(receiverAcc.Bytes())
+	if len(opts.Recipient) > 0 {
+		recipient = common.HexToAddress(opts.Recipient)
+	}
+
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.ConvertVouchersToEvmCoinsTo(cacheCtx, receiver, recipient, tokens); err != nil {
+		return err
+	}
+	commit()
+	return nil
+}
+
+// OnRefundVouchers try to convert the vouchers refunded by a failed or timed-out packet back to the evm coins
+// of the original sender, the packet commitment is deleted by the ibc core before, so a packet is refunded once.
+func (k Keeper) OnRefundVouchers(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	tokens sdk.Coins,
+	sender string,
+) {
+	// the refunds are converted back in full, regardless of the conversion params
+	senderAcc, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		k.Logger(ctx).Error("invalid sender of the refunded packet", "sender", sender, "error", err)
+		return
+	}
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.convertVouchers(cacheCtx, sender, common.BytesToAddress(senderAcc.Bytes()), tokens); err != nil {
+		k.Logger(ctx).Error(
+			fmt.Sprintf("Failed to convert refunded vouchers to evm tokens for sender %s, coins %s. Receive error %s",
+				sender, tokens.String(), err))
+		k.emitConversionFailedEvent(ctx, tokens, err)
+		return
 	}
+	commit()
+
+	ctx.EventManager().EmitEvent(
+		types.NewRefundVouchersEvent(sender, packet.SourceChannel, packet.Sequence, tokens),
+	)
 }
 
 func (k Keeper) GetAccount(ctx sdk.Context, addr sdk.AccAddress) sdk.AccountI {
@@ -256,20 +435,18 @@ func (k Keeper) RegisterOrUpdateTokenMapping(ctx sdk.Context, msg *types.MsgUpda
 		k.bankKeeper.SetDenomMetaData(ctx, metadata)
 
 		// update the mapping
-		if err := k.SetExternalContractForDenom(ctx, msg.Denom, common.HexToAddress(msg.Contract)); err != nil {
+		if err := k.SetExternalContractForDenom(ctx, msg.Denom, msg.Contract); err != nil {
 			return err
 		}
 	} else {
 		if len(msg.Contract) == 0 {
 			// delete existing mapping
-			k.DeleteExternalContractForDenom(ctx, msg.Denom)
-		} else {
-			if !common.IsHexAddress(msg.Contract) {
-				return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", msg.Contract)
+			if err := k.DeleteTokenMapping(ctx, msg.Denom); err != nil {
+				return err
 			}
+		} else {
 			// update the mapping
-			contract := common.HexToAddress(msg.Contract)
-			if err := k.SetExternalContractForDenom(ctx, msg.Denom, contract); err != nil {
+			if err := k.SetExternalContractForDenom(ctx, msg.Denom, msg.Contract); err != nil {
 				return err
 			}
 		}
@@ -278,6 +455,19 @@ func (k Keeper) RegisterOrUpdateTokenMapping(ctx sdk.Context, msg *types.MsgUpda
 	return nil
 }
 
+// validateMappedContract parses the contract address of a token mapping and checks there's code deployed at it
+func (k Keeper) validateMappedContract(ctx sdk.Context, address string) (common.Address, error) {
+	contract, err := types.ParseContractAddress(address)
+	if err != nil {
+		return common.Address{}, errors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	acct := k.evmKeeper.GetAccount(ctx, contract)
+	if acct == nil || !acct.IsContract() {
+		return common.Address{}, errors.Wrapf(types.ErrContractCodeNotFound, "no code deployed at %s", contract.Hex())
+	}
+	return contract, nil
+}
+
 func (k Keeper) onPacketResult(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
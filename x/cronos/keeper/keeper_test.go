@@ -17,14 +17,21 @@ import (
 	tmversion "github.com/cometbft/cometbft/proto/tendermint/version"
 	"github.com/cometbft/cometbft/version"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	localhost "github.com/cosmos/ibc-go/v8/modules/light-clients/09-localhost"
 	"github.com/ethereum/go-ethereum/common"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 	ethermint "github.com/evmos/ethermint/types"
+	"github.com/evmos/ethermint/x/evm/statedb"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
@@ -109,6 +116,10 @@ func (suite *KeeperTestSuite) DoSetupTest(t *testing.T) {
 	suite.app.StakingKeeper.SetValidator(suite.ctx, validator)
 
 	suite.evmParam = suite.app.EvmKeeper.GetParams(suite.ctx)
+
+	// the channels of the mocked denom traces
+	suite.SetChannel("channel-0", channeltypes.OPEN)
+	suite.SetChannel("channel-1", channeltypes.OPEN)
 }
 
 func (suite *KeeperTestSuite) SetupTest() {
@@ -127,6 +138,54 @@ func (suite *KeeperTestSuite) MintCoins(address sdk.AccAddress, coins sdk.Coins)
 	return nil
 }
 
+// SetChannel stores a transfer channel in the given state
+func (suite *KeeperTestSuite) SetChannel(channelID string, state channeltypes.State) {
+	channel := channeltypes.NewChannel(state, channeltypes.UNORDERED, channeltypes.NewCounterparty(ibctransfertypes.PortID, "channel-100"), []string{"connection-0"}, ibctransfertypes.Version)
+	suite.app.IBCKeeper.ChannelKeeper.SetChannel(suite.ctx, ibctransfertypes.PortID, channelID, channel)
+}
+
+// OpenLocalhostTransferChannels opens a pair of transfer channels over the localhost connection, the packets sent on
+// one of them are relayed by receiving them on the other one, it returns the two channel ids.
+func (suite *KeeperTestSuite) OpenLocalhostTransferChannels() (string, string) {
+	signer := sdk.AccAddress(suite.address.Bytes()).String()
+	hops := []string{exported.LocalhostConnectionID}
+	proofHeight := clienttypes.GetSelfHeight(suite.ctx)
+
+	initRes, err := suite.app.IBCKeeper.ChannelOpenInit(suite.ctx, channeltypes.NewMsgChannelOpenInit(
+		ibctransfertypes.PortID, ibctransfertypes.Version, channeltypes.UNORDERED, hops, ibctransfertypes.PortID, signer,
+	))
+	suite.Require().NoError(err)
+	tryRes, err := suite.app.IBCKeeper.ChannelOpenTry(suite.ctx, channeltypes.NewMsgChannelOpenTry(
+		ibctransfertypes.PortID, ibctransfertypes.Version, channeltypes.UNORDERED, hops, ibctransfertypes.PortID, initRes.ChannelId,
+		ibctransfertypes.Version, localhost.SentinelProof, proofHeight, signer,
+	))
+	suite.Require().NoError(err)
+	_, err = suite.app.IBCKeeper.ChannelOpenAck(suite.ctx, channeltypes.NewMsgChannelOpenAck(
+		ibctransfertypes.PortID, initRes.ChannelId, tryRes.ChannelId, ibctransfertypes.Version, localhost.SentinelProof, proofHeight, signer,
+	))
+	suite.Require().NoError(err)
+	_, err = suite.app.IBCKeeper.ChannelOpenConfirm(suite.ctx, channeltypes.NewMsgChannelOpenConfirm(
+		ibctransfertypes.PortID, tryRes.ChannelId, localhost.SentinelProof, proofHeight, signer,
+	))
+	suite.Require().NoError(err)
+	return initRes.ChannelId, tryRes.ChannelId
+}
+
+// RecvLocalhostPacket relays a packet sent over a localhost channel
+func (suite *KeeperTestSuite) RecvLocalhostPacket(packet channeltypes.Packet) (*channeltypes.MsgRecvPacketResponse, error) {
+	return suite.app.IBCKeeper.RecvPacket(suite.ctx, channeltypes.NewMsgRecvPacket(
+		packet, localhost.SentinelProof, clienttypes.GetSelfHeight(suite.ctx), sdk.AccAddress(suite.address.Bytes()).String(),
+	))
+}
+
+// SetContractCode deploys a dummy code at the address, so it can be mapped to a denom
+func (suite *KeeperTestSuite) SetContractCode(address common.Address) {
+	code := []byte{0x60, 0x00}
+	codeHash := ethcrypto.Keccak256(code)
+	suite.app.EvmKeeper.SetCode(suite.ctx, codeHash, code)
+	suite.Require().NoError(suite.app.EvmKeeper.SetAccount(suite.ctx, address, statedb.Account{CodeHash: codeHash}))
+}
+
 func (suite *KeeperTestSuite) RegisterSourceToken(
 	contractAddress, symbol string, decimal uint32,
 ) error {
@@ -165,19 +224,48 @@ func (suite *KeeperTestSuite) TestDenomContractMap() {
 				suite.Require().True(found)
 				suite.Require().Equal(autoContract, contract)
 
-				keeper.SetExternalContractForDenom(suite.ctx, denom1, externalContract)
+				keeper.SetExternalContractMapping(suite.ctx, denom1, externalContract)
 
 				contract, found = keeper.GetContractByDenom(suite.ctx, denom1)
 				suite.Require().True(found)
 				suite.Require().Equal(externalContract, contract)
 			},
 		},
+		{
+			"success, reverse index follows the mappings",
+			func() {
+				keeper := suite.app.CronosKeeper
+
+				keeper.SetAutoContractForDenom(suite.ctx, denom1, autoContract)
+				denom, found := keeper.GetDenomByContract(suite.ctx, autoContract)
+				suite.Require().True(found)
+				suite.Require().Equal(denom1, denom)
+
+				// replace the auto contract, the stale reverse index is removed
+				newAutoContract := common.BigToAddress(big.NewInt(3))
+				keeper.SetAutoContractForDenom(suite.ctx, denom1, newAutoContract)
+				_, found = keeper.GetDenomByContract(suite.ctx, autoContract)
+				suite.Require().False(found)
+				denom, found = keeper.GetDenomByContract(suite.ctx, newAutoContract)
+				suite.Require().True(found)
+				suite.Require().Equal(denom1, denom)
+
+				suite.Require().NoError(keeper.SetExternalContractMapping(suite.ctx, denom2, externalContract))
+				denom, found = keeper.GetDenomByContract(suite.ctx, externalContract)
+				suite.Require().True(found)
+				suite.Require().Equal(denom2, denom)
+
+				suite.Require().True(keeper.DeleteExternalContractForDenom(suite.ctx, denom2))
+				_, found = keeper.GetDenomByContract(suite.ctx, externalContract)
+				suite.Require().False(found)
+			},
+		},
 		{
 			"failure, multiple denoms map to same contract",
 			func() {
 				keeper := suite.app.CronosKeeper
 				keeper.SetAutoContractForDenom(suite.ctx, denom1, autoContract)
-				err := keeper.SetExternalContractForDenom(suite.ctx, denom2, autoContract)
+				err := keeper.SetExternalContractMapping(suite.ctx, denom2, autoContract)
 				suite.Require().Error(err)
 			},
 		},
@@ -185,9 +273,9 @@ func (suite *KeeperTestSuite) TestDenomContractMap() {
 			"failure, multiple denoms map to same external contract",
 			func() {
 				keeper := suite.app.CronosKeeper
-				err := keeper.SetExternalContractForDenom(suite.ctx, denom1, externalContract)
+				err := keeper.SetExternalContractMapping(suite.ctx, denom1, externalContract)
 				suite.Require().NoError(err)
-				err = keeper.SetExternalContractForDenom(suite.ctx, denom2, externalContract)
+				err = keeper.SetExternalContractMapping(suite.ctx, denom2, externalContract)
 				suite.Require().Error(err)
 			},
 		},
@@ -201,6 +289,86 @@ func (suite *KeeperTestSuite) TestDenomContractMap() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSetExternalContractForDenom() {
+	contract := common.HexToAddress("0xF6D4FeCB1a6fb7C2CA350169A050D483bd87b883")
+	oldContract := common.BigToAddress(big.NewInt(1))
+
+	testCases := []struct {
+		name     string
+		denom    string
+		address  string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"invalid denom",
+			"test",
+			contract.Hex(),
+			func() { suite.SetContractCode(contract) },
+			sdkerrors.ErrInvalidRequest,
+		},
+		{
+			"invalid checksum",
+			CorrectIbcDenom,
+			"0xf6D4FeCB1a6fb7C2CA350169A050D483bd87b883",
+			func() { suite.SetContractCode(contract) },
+			sdkerrors.ErrInvalidAddress,
+		},
+		{
+			"no code deployed",
+			CorrectIbcDenom,
+			contract.Hex(),
+			func() {},
+			types.ErrContractCodeNotFound,
+		},
+		{
+			"success, lower case address",
+			CorrectIbcDenom,
+			"0xf6d4fecb1a6fb7c2ca350169a050d483bd87b883",
+			func() { suite.SetContractCode(contract) },
+			nil,
+		},
+		{
+			"success, replace the existing mapping",
+			CorrectIbcDenom,
+			contract.Hex(),
+			func() {
+				suite.SetContractCode(contract)
+				suite.Require().NoError(suite.app.CronosKeeper.SetExternalContractMapping(suite.ctx, CorrectIbcDenom, oldContract))
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+			keeper := suite.app.CronosKeeper
+
+			err := keeper.SetExternalContractForDenom(suite.ctx, tc.denom, tc.address)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			mapped, found := keeper.GetContractByDenom(suite.ctx, tc.denom)
+			suite.Require().True(found)
+			suite.Require().Equal(contract, mapped)
+			// the mapping is registered as an external one
+			suite.Require().Equal([]types.TokenMapping{{Denom: tc.denom, Contract: contract.Hex()}}, keeper.GetExternalContracts(suite.ctx))
+			suite.Require().Empty(keeper.GetAutoContracts(suite.ctx))
+
+			mappedDenom, found := keeper.GetDenomByContract(suite.ctx, contract)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.denom, mappedDenom)
+			_, found = keeper.GetDenomByContract(suite.ctx, oldContract)
+			suite.Require().False(found)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) MintCoinsToModule(module string, coins sdk.Coins) error {
 	err := suite.app.BankKeeper.MintCoins(suite.ctx, module, coins)
 	if err != nil {
@@ -276,10 +444,14 @@ func (suite *KeeperTestSuite) TestOnRecvVouchers() {
 				suite.app.EncodingConfig().Codec,
 				suite.app.GetKey(types.StoreKey),
 				suite.app.GetKey(types.MemStoreKey),
+				suite.app.GetObjKey(types.ObjectStoreKey),
 				suite.app.BankKeeper,
 				keepertest.IbcKeeperMock{},
 				suite.app.EvmKeeper,
 				suite.app.AccountKeeper,
+				suite.app.DistrKeeper,
+				suite.app.StakingKeeper,
+				suite.app.IBCKeeper.ChannelKeeper,
 				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 			)
 			suite.app.CronosKeeper = cronosKeeper
@@ -291,6 +463,112 @@ func (suite *KeeperTestSuite) TestOnRecvVouchers() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestDeleteTokenMapping() {
+	denom := "gravity0xf6d4fecb1a6fb7c2ca350169a050d483bd87b883"
+	// the addresses are out of the range of the precompiled contracts
+	external := common.BigToAddress(big.NewInt(1001))
+	auto := common.BigToAddress(big.NewInt(1002))
+	deployed := suite.app.CronosKeeper.ModuleCRC21Address(suite.ctx, denom)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expErr      error
+		expContract common.Address
+		expDeleted  common.Address
+	}{
+		{
+			"no mapping",
+			func() {},
+			sdkerrors.ErrInvalidRequest,
+			common.Address{},
+			common.Address{},
+		},
+		{
+			"delete the external contract",
+			func() {
+				suite.Require().NoError(suite.app.CronosKeeper.SetExternalContractMapping(suite.ctx, denom, external))
+			},
+			nil,
+			common.Address{},
+			external,
+		},
+		{
+			"delete the auto deployed contract",
+			func() {
+				suite.app.CronosKeeper.SetAutoContractForDenom(suite.ctx, denom, auto)
+			},
+			nil,
+			common.Address{},
+			auto,
+		},
+		{
+			"the auto deployed contract is restored after deleting the external one",
+			func() {
+				suite.app.CronosKeeper.SetAutoContractForDenom(suite.ctx, denom, auto)
+				suite.Require().NoError(suite.app.CronosKeeper.SetExternalContractMapping(suite.ctx, denom, external))
+			},
+			nil,
+			auto,
+			external,
+		},
+		{
+			"coins sent to the contract without a conversion",
+			func() {
+				suite.Require().NoError(suite.app.CronosKeeper.SetExternalContractMapping(suite.ctx, denom, external))
+				suite.Require().NoError(suite.MintCoins(sdk.AccAddress(external.Bytes()), sdk.NewCoins(sdk.NewCoin(denom, sdkmath.OneInt()))))
+			},
+			nil,
+			common.Address{},
+			external,
+		},
+		{
+			"supply not empty",
+			func() {
+				contract, err := suite.app.CronosKeeper.DeployModuleCRC21(suite.ctx, denom)
+				suite.Require().NoError(err)
+				suite.Require().NoError(suite.app.CronosKeeper.SetExternalContractMapping(suite.ctx, denom, contract))
+				coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.OneInt()))
+				suite.Require().NoError(suite.MintCoins(sdk.AccAddress(suite.address.Bytes()), coins))
+				suite.Require().NoError(suite.app.CronosKeeper.ConvertCoinsFromNativeToCRC21(suite.ctx, suite.address, coins, false))
+			},
+			types.ErrSupplyNotEmpty,
+			deployed,
+			common.Address{},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+			ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+			msg := types.NewMsgUpdateTokenMapping(suite.address.String(), denom, "", "", 0)
+			err := suite.app.CronosKeeper.RegisterOrUpdateTokenMapping(ctx, msg)
+
+			contract, found := suite.app.CronosKeeper.GetContractByDenom(suite.ctx, denom)
+			suite.Require().Equal(tc.expContract != common.Address{}, found)
+			suite.Require().Equal(tc.expContract, contract)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Empty(ctx.EventManager().Events())
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the reverse index is cleaned up
+			_, found = suite.app.CronosKeeper.GetDenomByContract(suite.ctx, tc.expDeleted)
+			suite.Require().False(found)
+
+			events := ctx.EventManager().Events()
+			suite.Require().Len(events, 1)
+			typed, err := sdk.ParseTypedEvent(events.ToABCIEvents()[0])
+			suite.Require().NoError(err)
+			suite.Require().Equal(&types.EventDeleteTokenMapping{Denom: denom, Contract: tc.expDeleted.Hex()}, typed)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestRegisterOrUpdateTokenMapping() {
 	contractAddress := "0xF6D4FeCB1a6fb7C2CA350169A050D483bd87b883"
 
@@ -339,6 +617,32 @@ func (suite *KeeperTestSuite) TestRegisterOrUpdateTokenMapping() {
 			},
 			true,
 		},
+		{
+			"Non source token, no code at the contract address, error",
+			types.MsgUpdateTokenMapping{
+				Sender:   "",
+				Denom:    "gravity0xf6d4fecb1a6fb7c2ca350169a050d483bd87b883",
+				Contract: "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2",
+				Symbol:   "",
+				Decimal:  0,
+			},
+			func() {
+			},
+			true,
+		},
+		{
+			"Source token, no code at the contract address, error",
+			types.MsgUpdateTokenMapping{
+				Sender:   "",
+				Denom:    "cronos0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2",
+				Contract: "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2",
+				Symbol:   "",
+				Decimal:  0,
+			},
+			func() {
+			},
+			true,
+		},
 		{
 			"Non source token, already exists, no error",
 			types.MsgUpdateTokenMapping{
@@ -349,7 +653,7 @@ func (suite *KeeperTestSuite) TestRegisterOrUpdateTokenMapping() {
 				Decimal:  0,
 			},
 			func() {
-				err := suite.app.CronosKeeper.SetExternalContractForDenom(
+				err := suite.app.CronosKeeper.SetExternalContractMapping(
 					suite.ctx,
 					"gravity0xf6d4fecb1a6fb7c2ca350169a050d483bd87b883",
 					common.HexToAddress(contractAddress))
@@ -407,13 +711,18 @@ func (suite *KeeperTestSuite) TestRegisterOrUpdateTokenMapping() {
 				suite.app.EncodingConfig().Codec,
 				suite.app.GetKey(types.StoreKey),
 				suite.app.GetKey(types.MemStoreKey),
+				suite.app.GetObjKey(types.ObjectStoreKey),
 				suite.app.BankKeeper,
 				keepertest.IbcKeeperMock{},
 				suite.app.EvmKeeper,
 				suite.app.AccountKeeper,
+				suite.app.DistrKeeper,
+				suite.app.StakingKeeper,
+				suite.app.IBCKeeper.ChannelKeeper,
 				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 			)
 			suite.app.CronosKeeper = cronosKeeper
+			suite.SetContractCode(common.HexToAddress(contractAddress))
 
 			tc.malleate()
 			err := suite.app.CronosKeeper.RegisterOrUpdateTokenMapping(suite.ctx, &tc.msg)
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	v2 "github.com/crypto-org-chain/cronos/v2/x/cronos/migrations/v2"
+	v3 "github.com/crypto-org-chain/cronos/v2/x/cronos/migrations/v3"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -22,3 +23,8 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	err := v2.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.legacySubspace, m.keeper.cdc)
 	return err
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.Migrate(ctx.KVStore(m.keeper.storeKey))
+}
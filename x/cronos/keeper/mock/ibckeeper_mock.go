@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"context"
+	"errors"
 
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -27,5 +28,54 @@ func (i IbcKeeperMock) GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.Hex
 			BaseDenom: "correctIBCToken",
 		}, true
 	}
+	if denomTraceHash.String() == "27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2" {
+		return types.DenomTrace{
+			Path:      "transfer/channel-0",
+			BaseDenom: "uatom",
+		}, true
+	}
+	if denomTraceHash.String() == "8C2C70C47936CF18CD46D44B9C83AF54DDAA84EE47A69CC451E45B5DF6C87BB3" {
+		return types.DenomTrace{
+			Path:      "transfer/channel-1/transfer/channel-5",
+			BaseDenom: "uatom",
+		}, true
+	}
+	if denomTraceHash.String() == "CCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCC" {
+		return types.DenomTrace{
+			Path:      "transfer",
+			BaseDenom: "malformed",
+		}, true
+	}
 	return types.DenomTrace{}, false
 }
+
+// CountingIbcKeeperMock is an IbcKeeperMock counting the denom trace lookups
+type CountingIbcKeeperMock struct {
+	IbcKeeperMock
+	Lookups *int
+}
+
+func (i CountingIbcKeeperMock) GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (types.DenomTrace, bool) {
+	*i.Lookups++
+	return i.IbcKeeperMock.GetDenomTrace(ctx, denomTraceHash)
+}
+
+// RecordingIbcKeeperMock is an IbcKeeperMock recording the transfers it's called with
+type RecordingIbcKeeperMock struct {
+	IbcKeeperMock
+	Transfers *[]*types.MsgTransfer
+}
+
+func (i RecordingIbcKeeperMock) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
+	*i.Transfers = append(*i.Transfers, msg)
+	return i.IbcKeeperMock.Transfer(goCtx, msg)
+}
+
+// FailingIbcKeeperMock is an IbcKeeperMock failing the transfers
+type FailingIbcKeeperMock struct {
+	IbcKeeperMock
+}
+
+func (i FailingIbcKeeperMock) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
+	return nil, errors.New("transfer failed")
+}
@@ -2,14 +2,20 @@ package keeper
 
 import (
 	"context"
+	"slices"
 
 	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
+// ConvertVouchersGasPerDenom defines the gas charged for each denom converted in MsgConvertVouchers
+const ConvertVouchersGasPerDenom uint64 = 10000
+
 type msgServer struct {
 	Keeper
 }
@@ -24,43 +30,194 @@ var _ types.MsgServer = msgServer{}
 
 func (k msgServer) ConvertVouchers(goCtx context.Context, msg *types.MsgConvertVouchers) (*types.MsgConvertVouchersResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	err := k.ConvertVouchersToEvmCoins(ctx, msg.Address, msg.Coins)
+	if k.GetParams(ctx).ConversionPaused {
+		return nil, types.ErrConversionPaused
+	}
+	// charge gas for each denom, every one of them involves an escrow and an evm call
+	ctx.GasMeter().ConsumeGas(ConvertVouchersGasPerDenom*uint64(len(msg.Coins)), "convert vouchers")
+
+	sender, err := sdk.AccAddressFromBech32(msg.Address)
 	if err != nil {
 		return nil, err
 	}
+	recipient := common.BytesToAddress(sender.Bytes())
+	if msg.Recipient != "" {
+		recipient = common.HexToAddress(msg.Recipient)
+	}
 
-	// emit events
-	ctx.EventManager().EmitEvents(sdk.Events{
-		types.NewConvertVouchersEvent(msg.Address, msg.Coins),
+	// the limits apply to the amounts requested, the amounts net of the fees are converted
+	if err := k.applyConversionLimits(ctx, msg.Coins); err != nil {
+		return nil, err
+	}
+	converted, err := k.chargeConversionFees(ctx, sender, msg.Coins)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.convertVouchers(ctx, msg.Address, recipient, converted); err != nil {
+		return nil, err
+	}
+	if err := k.emitConvertVouchersEvents(ctx, msg.Address, recipient, msg.Coins); err != nil {
+		return nil, err
+	}
+
+	if err := k.Hooks().AfterConvertVouchers(ctx, sender, recipient, converted); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgConvertVouchersResponse{}, nil
+}
+
+// emitConvertVouchersEvents emits the events of the vouchers converted to the evm coins of the recipient
+func (k msgServer) emitConvertVouchersEvents(ctx sdk.Context, sender string, recipient common.Address, coins sdk.Coins) error {
+	// emit one event per converted denom, followed by the summary event
+	events := make(sdk.Events, 0, len(coins)+2)
+	for _, c := range coins {
+		events = append(events, types.NewConvertVoucherEvent(sender, c))
+	}
+	events = append(events,
+		types.NewConvertVouchersEvent(sender, recipient.Hex(), coins),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 		),
-	},
 	)
+	ctx.EventManager().EmitEvents(events)
 
-	return &types.MsgConvertVouchersResponse{}, nil
+	params := k.GetParams(ctx)
+	for _, c := range coins {
+		var contractAddr string
+		if contract, found := k.GetContractByDenom(ctx, c.Denom); found {
+			contractAddr = contract.Hex()
+		}
+		// the gas token is converted in full
+		fee := sdkmath.ZeroInt()
+		if c.Denom != params.IbcCroDenom {
+			fee = params.GetConversionFee(c).Amount
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventConvertVouchers{
+			Sender:    sender,
+			Recipient: recipient.Hex(),
+			Denom:     c.Denom,
+			Contract:  contractAddr,
+			Amount:    c.Amount.String(),
+			Fee:       fee.String(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (k msgServer) TransferTokens(goCtx context.Context, msg *types.MsgTransferTokens) (*types.MsgTransferTokensResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	// TODO change the msg to be able to specify the channel id
-	// Only sending non source token is supported at the moment
-	err := k.IbcTransferCoins(ctx, msg.From, msg.To, msg.Coins, "")
+	if k.GetParams(ctx).ConversionPaused {
+		return nil, types.ErrConversionPaused
+	}
+	if err := k.validateTransferChannel(ctx, msg.Coins, msg.ChannelId); err != nil {
+		return nil, err
+	}
+	err := k.IbcTransferCoinsWithOptions(ctx, msg.From, msg.To, msg.Coins, msg.ChannelId, types.IbcTransferOptions{
+		TimeoutHeight:    msg.TimeoutHeight,
+		TimeoutTimestamp: msg.TimeoutTimestamp,
+		Memo:             msg.Memo,
+	})
 	if err != nil {
 		return nil, err
 	}
+	if err := k.emitTransferTokensEvents(ctx, msg.From, msg.To, msg.Coins); err != nil {
+		return nil, err
+	}
+	return &types.MsgTransferTokensResponse{}, nil
+}
 
-	// emit events
+// validateTransferChannel rejects a channel id different from the one the vouchers were received from, they're always
+// sent back through the channel of their denom trace, the channel id only applies to the tokens originated from cronos.
+func (k msgServer) validateTransferChannel(ctx sdk.Context, coins sdk.Coins, channelId string) error {
+	if channelId == "" {
+		return nil
+	}
+	ibcCroDenom := k.GetParams(ctx).IbcCroDenom
+	evmDenom := k.GetEvmParams(ctx).EvmDenom
+	for _, c := range coins {
+		denom := c.Denom
+		if denom == evmDenom {
+			denom = ibcCroDenom
+		}
+		if types.IsSourceCoin(denom) {
+			continue
+		}
+		sourceChannelID, err := k.GetSourceChannelID(ctx, denom)
+		if err != nil {
+			// the unknown denom traces are reported by the transfer
+			continue
+		}
+		if channelId != sourceChannelID {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "voucher %s must be sent back through channel %s, not %s", denom, sourceChannelID, channelId)
+		}
+	}
+	return nil
+}
+
+// emitTransferTokensEvents emits the events of the tokens transferred to the receiver through IBC
+func (k msgServer) emitTransferTokensEvents(ctx sdk.Context, from, to string, coins sdk.Coins) error {
 	ctx.EventManager().EmitEvents(sdk.Events{
-		types.NewTransferTokensEvent(msg.From, msg.To, msg.Coins),
+		types.NewTransferTokensEvent(from, to, coins),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 		),
 	},
 	)
-	return &types.MsgTransferTokensResponse{}, nil
+	for _, c := range coins {
+		var contractAddr string
+		if contract, found := k.GetContractByDenom(ctx, c.Denom); found {
+			contractAddr = contract.Hex()
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventTransferTokens{
+			Sender:    from,
+			Recipient: to,
+			Denom:     c.Denom,
+			Contract:  contractAddr,
+			Amount:    c.Amount.String(),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ConvertAndTransfer implements the grpc method
+func (k msgServer) ConvertAndTransfer(goCtx context.Context, msg *types.MsgConvertAndTransfer) (*types.MsgConvertAndTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if k.GetParams(ctx).ConversionPaused {
+		return nil, types.ErrConversionPaused
+	}
+	// charge gas for each denom like MsgConvertVouchers
+	ctx.GasMeter().ConsumeGas(ConvertVouchersGasPerDenom*uint64(len(msg.Coins)), "convert vouchers")
+
+	transferred, err := k.Keeper.ConvertAndTransfer(ctx, msg.Address, msg.Coins, msg.Transfer, msg.To, msg.ChannelId, types.IbcTransferOptions{
+		TimeoutHeight:    msg.TimeoutHeight,
+		TimeoutTimestamp: msg.TimeoutTimestamp,
+		Memo:             msg.Memo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.emitConvertVouchersEvents(ctx, msg.Address, common.BytesToAddress(sender.Bytes()), msg.Coins); err != nil {
+		return nil, err
+	}
+	if msg.Transfer {
+		if err := k.emitTransferTokensEvents(ctx, msg.Address, msg.To, transferred); err != nil {
+			return nil, err
+		}
+	}
+	return &types.MsgConvertAndTransferResponse{}, nil
 }
 
 // UpdateTokenMapping implements the grpc method
@@ -89,19 +246,31 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
 	}
 
+	// the admin can be rotated but not removed
+	if len(msg.Params.CronosAdmins) == 0 {
+		return nil, types.ErrCronosAdminEmpty
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	oldParams := k.GetParams(ctx)
 	if err := k.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
 	}
 
+	if !slices.Equal(oldParams.CronosAdmins, msg.Params.CronosAdmins) {
+		ctx.EventManager().EmitEvent(types.NewUpdateAdminEvent(oldParams.CronosAdmins, msg.Params.CronosAdmins))
+	}
+	if oldParams.ConversionPaused != msg.Params.ConversionPaused {
+		ctx.EventManager().EmitEvent(types.NewConversionPauseEvent(msg.Params.ConversionPaused))
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
 func (k msgServer) UpdatePermissions(goCtx context.Context, msg *types.MsgUpdatePermissions) (*types.MsgUpdatePermissionsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	admin := k.Keeper.GetParams(ctx).CronosAdmin
-	// if admin is empty, no sender could be equal to it
-	if admin != msg.From {
+	// if there's no admin, no sender could be authorized
+	if !k.Keeper.GetParams(ctx).IsCronosAdmin(msg.From) {
 		return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "msg sender is not authorized")
 	}
 	acc, err := sdk.AccAddressFromBech32(msg.Address)
@@ -112,3 +281,56 @@ func (k msgServer) UpdatePermissions(goCtx context.Context, msg *types.MsgUpdate
 
 	return &types.MsgUpdatePermissionsResponse{}, nil
 }
+
+func (k msgServer) ConvertCoin(goCtx context.Context, msg *types.MsgConvertCoin) (*types.MsgConvertCoinResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if k.GetParams(ctx).ConversionPaused {
+		return nil, types.ErrConversionPaused
+	}
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := k.Keeper.ConvertCoin(ctx, sender, msg.Coin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		types.NewConvertCoinEvent(msg.Sender, contract.Hex(), msg.Coin),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventConvertCoin{
+		Sender:    msg.Sender,
+		Recipient: common.BytesToAddress(sender).Hex(),
+		Denom:     msg.Coin.Denom,
+		Contract:  contract.Hex(),
+		Amount:    msg.Coin.Amount.String(),
+		Fee:       k.GetParams(ctx).GetConversionFee(msg.Coin).Amount.String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.Hooks().AfterConvertCoin(ctx, sender, contract, msg.Coin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgConvertCoinResponse{}, nil
+}
+
+// RedeployContract implements the grpc method
+func (k msgServer) RedeployContract(goCtx context.Context, msg *types.MsgRedeployContract) (*types.MsgRedeployContractResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	contract, err := k.Keeper.RedeployContract(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRedeployContractResponse{Contract: contract.Hex()}, nil
+}
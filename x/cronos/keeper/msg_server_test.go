@@ -1,12 +1,23 @@
 package keeper_test
 
 import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
 	cronosmodulekeeper "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
+	keepertest "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper/mock"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 func (suite *KeeperTestSuite) TestUpdateParams() {
@@ -23,7 +34,7 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 				Params: types.Params{
 					IbcCroDenom:          types.IbcCroDenomDefaultValue,
 					IbcTimeout:           10,
-					CronosAdmin:          sdk.AccAddress(suite.address.Bytes()).String(),
+					CronosAdmins:         []string{sdk.AccAddress(suite.address.Bytes()).String()},
 					EnableAutoDeployment: true,
 				},
 			},
@@ -45,7 +56,7 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 				Params: types.Params{
 					IbcCroDenom:          "foo",
 					IbcTimeout:           10,
-					CronosAdmin:          sdk.AccAddress(suite.address.Bytes()).String(),
+					CronosAdmins:         []string{sdk.AccAddress(suite.address.Bytes()).String()},
 					EnableAutoDeployment: true,
 				},
 			},
@@ -59,13 +70,36 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 				Params: types.Params{
 					IbcCroDenom:          types.IbcCroDenomDefaultValue,
 					IbcTimeout:           10,
-					CronosAdmin:          "foo",
+					CronosAdmins:         []string{"foo"},
 					EnableAutoDeployment: true,
 				},
 			},
 			expectErr: true,
 			expErrMsg: "invalid bech32 string",
 		},
+		{
+			name: "set empty cronos admin",
+			req: &types.MsgUpdateParams{
+				Authority: suite.app.CronosKeeper.GetAuthority(),
+				Params: types.Params{
+					IbcCroDenom:          types.IbcCroDenomDefaultValue,
+					IbcTimeout:           10,
+					CronosAdmins:         nil,
+					EnableAutoDeployment: true,
+				},
+			},
+			expectErr: true,
+			expErrMsg: "cronos admin is not set",
+		},
+		{
+			name: "non gov authority",
+			req: &types.MsgUpdateParams{
+				Authority: sdk.AccAddress(suite.address.Bytes()).String(),
+				Params:    types.DefaultParams(),
+			},
+			expectErr: true,
+			expErrMsg: "invalid authority",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -82,3 +116,808 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateParamsRotateAdmin() {
+	suite.SetupTest()
+	oldAdmin := suite.app.CronosKeeper.GetParams(suite.ctx).CronosAdmins[0]
+	newAdmin := sdk.AccAddress([]byte("new_cronos_admin____")).String()
+	params := suite.app.CronosKeeper.GetParams(suite.ctx)
+	params.CronosAdmins = []string{newAdmin}
+	msgServer := cronosmodulekeeper.NewMsgServerImpl(suite.app.CronosKeeper)
+
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err := msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(suite.app.CronosKeeper.GetAuthority(), params))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{newAdmin}, suite.app.CronosKeeper.GetParams(suite.ctx).CronosAdmins)
+
+	events := ctx.EventManager().Events()
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(types.EventTypeUpdateAdmin, events[0].Type)
+	suite.Require().Equal(types.AttributeKeyOldAdmin, events[0].Attributes[0].Key)
+	suite.Require().Equal(oldAdmin, events[0].Attributes[0].Value)
+	suite.Require().Equal(types.AttributeKeyNewAdmin, events[0].Attributes[1].Key)
+	suite.Require().Equal(newAdmin, events[0].Attributes[1].Value)
+
+	// the new admin is authorized, the old one is not
+	msg := types.NewMsgUpdatePermissions(newAdmin, oldAdmin, cronosmodulekeeper.CanChangeTokenMapping)
+	_, err = msgServer.UpdatePermissions(suite.ctx, msg)
+	suite.Require().NoError(err)
+	msg = types.NewMsgUpdatePermissions(oldAdmin, newAdmin, cronosmodulekeeper.CanChangeTokenMapping)
+	_, err = msgServer.UpdatePermissions(suite.ctx, msg)
+	suite.Require().Error(err)
+
+	// no event when the admin is unchanged
+	ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(suite.app.CronosKeeper.GetAuthority(), params))
+	suite.Require().NoError(err)
+	suite.Require().Empty(ctx.EventManager().Events())
+}
+
+func (suite *KeeperTestSuite) TestConvertVouchersBatch() {
+	address := sdk.AccAddress(suite.address.Bytes())
+	coins := sdk.NewCoins(
+		sdk.NewCoin(types.IbcCroDenomDefaultValue, sdkmath.NewInt(123)),
+		sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(456)),
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expectErr bool
+		postCheck func()
+	}{
+		{
+			"one event per denom plus a summary event",
+			func() {
+				suite.Require().NoError(suite.MintCoins(address, coins))
+			},
+			false,
+			func() {
+				var perDenom []sdk.Event
+				var summary []sdk.Event
+				for _, e := range suite.ctx.EventManager().Events() {
+					switch e.Type {
+					case types.EventTypeConvertVoucher:
+						perDenom = append(perDenom, e)
+					case types.EventTypeConvertVouchers:
+						summary = append(summary, e)
+					}
+				}
+				suite.Require().Len(perDenom, len(coins))
+				suite.Require().Len(summary, 1)
+				for i, e := range perDenom {
+					denom, found := e.GetAttribute(types.AttributeKeyDenom)
+					suite.Require().True(found)
+					suite.Require().Equal(coins[i].Denom, denom.Value)
+				}
+				suite.Require().True(suite.GetBalance(address, CorrectIbcDenom).IsZero())
+				suite.Require().True(suite.GetBalance(address, types.IbcCroDenomDefaultValue).IsZero())
+			},
+		},
+		{
+			"fails if one of the denoms can't be converted",
+			func() {
+				// only fund the first denom
+				suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(coins[0])))
+			},
+			true,
+			func() {},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+			tc.malleate()
+			msgServer := cronosmodulekeeper.NewMsgServerImpl(suite.app.CronosKeeper)
+			gasBefore := suite.ctx.GasMeter().GasConsumed()
+			_, err := msgServer.ConvertVouchers(suite.ctx, types.NewMsgConvertVouchers(address.String(), coins))
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().GreaterOrEqual(
+					suite.ctx.GasMeter().GasConsumed()-gasBefore,
+					cronosmodulekeeper.ConvertVouchersGasPerDenom*uint64(len(coins)),
+				)
+			}
+			tc.postCheck()
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertVouchersRecipient() {
+	address := sdk.AccAddress(suite.address.Bytes())
+	recipient := common.BigToAddress(big.NewInt(0xc0ffee))
+
+	testCases := []struct {
+		name        string
+		denom       string
+		recipient   string
+		expReceiver common.Address
+	}{
+		{"default to the sender", CorrectIbcDenom, "", common.BytesToAddress(address.Bytes())},
+		{"mint to the recipient", CorrectIbcDenom, recipient.Hex(), recipient},
+		{"gas token to the recipient", types.IbcCroDenomDefaultValue, recipient.Hex(), recipient},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			coin := sdk.NewCoin(tc.denom, sdkmath.NewInt(100))
+			suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(coin)))
+
+			msgServer := cronosmodulekeeper.NewMsgServerImpl(suite.app.CronosKeeper)
+			ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+			msg := types.NewMsgConvertVouchers(address.String(), sdk.NewCoins(coin))
+			msg.Recipient = tc.recipient
+			_, err := msgServer.ConvertVouchers(ctx, msg)
+			suite.Require().NoError(err)
+			suite.Require().True(suite.GetBalance(address, tc.denom).IsZero())
+
+			if tc.denom == types.IbcCroDenomDefaultValue {
+				evmDenom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+				suite.Require().Equal(
+					coin.Amount.Mul(sdkmath.NewIntFromBigInt(types.TenPowTen)),
+					suite.GetBalance(sdk.AccAddress(tc.expReceiver.Bytes()), evmDenom).Amount,
+				)
+			} else {
+				contract, found := suite.app.CronosKeeper.GetContractByDenom(suite.ctx, tc.denom)
+				suite.Require().True(found)
+				ret, err := suite.app.CronosKeeper.CallModuleCRC21(suite.ctx, contract, "balanceOf", tc.expReceiver)
+				suite.Require().NoError(err)
+				suite.Require().Equal(coin.Amount.BigInt(), new(big.Int).SetBytes(ret))
+			}
+
+			events := parseLegacyEvents(ctx, types.EventTypeConvertVouchers)
+			suite.Require().Len(events, 1)
+			attr, found := events[0].GetAttribute(types.AttributeKeyRecipient)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expReceiver.Hex(), attr.Value)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertCoin() {
+	denom := "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+	sender := sdk.AccAddress(suite.address.Bytes())
+	coin := sdk.NewCoin(denom, sdkmath.NewInt(100))
+
+	testCases := []struct {
+		name      string
+		malleate  func() common.Address
+		expErrMsg string
+	}{
+		{
+			"no registered contract",
+			func() common.Address {
+				suite.Require().NoError(suite.MintCoins(sender, sdk.NewCoins(coin)))
+				return common.Address{}
+			},
+			"no contract found for the denom",
+		},
+		{
+			"insufficient balance",
+			func() common.Address {
+				contract, err := suite.app.CronosKeeper.DeployModuleCRC21(suite.ctx, denom)
+				suite.Require().NoError(err)
+				suite.Require().NoError(suite.app.CronosKeeper.SetExternalContractMapping(suite.ctx, denom, contract))
+				suite.Require().NoError(suite.MintCoins(sender, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(99)))))
+				return contract
+			},
+			"insufficient funds",
+		},
+		{
+			"success",
+			func() common.Address {
+				contract, err := suite.app.CronosKeeper.DeployModuleCRC21(suite.ctx, denom)
+				suite.Require().NoError(err)
+				suite.Require().NoError(suite.app.CronosKeeper.SetExternalContractMapping(suite.ctx, denom, contract))
+				suite.Require().NoError(suite.MintCoins(sender, sdk.NewCoins(coin)))
+				return contract
+			},
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			contract := tc.malleate()
+			msgServer := cronosmodulekeeper.NewMsgServerImpl(suite.app.CronosKeeper)
+			ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+			_, err := msgServer.ConvertCoin(ctx, types.NewMsgConvertCoin(sender.String(), coin))
+			if tc.expErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the coin is escrowed and the crc20 tokens are minted to the sender
+			suite.Require().True(suite.GetBalance(sender, denom).IsZero())
+			suite.Require().Equal(coin.Amount, suite.GetBalance(sdk.AccAddress(contract.Bytes()), denom).Amount)
+			ret, err := suite.app.CronosKeeper.CallModuleCRC21(suite.ctx, contract, "balanceOf", common.BytesToAddress(sender.Bytes()))
+			suite.Require().NoError(err)
+			suite.Require().Equal(coin.Amount.BigInt(), new(big.Int).SetBytes(ret))
+
+			var found bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeConvertCoin {
+					found = true
+				}
+			}
+			suite.Require().True(found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConversionPaused() {
+	suite.SetupTest()
+	address := sdk.AccAddress(suite.address.Bytes())
+	coins := sdk.NewCoins(sdk.NewCoin(types.IbcCroDenomDefaultValue, sdkmath.NewInt(123)))
+	suite.Require().NoError(suite.MintCoins(address, coins))
+	msgServer := cronosmodulekeeper.NewMsgServerImpl(suite.app.CronosKeeper)
+
+	// pause the conversions
+	params := suite.app.CronosKeeper.GetParams(suite.ctx)
+	params.ConversionPaused = true
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err := msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(suite.app.CronosKeeper.GetAuthority(), params))
+	suite.Require().NoError(err)
+	events := ctx.EventManager().Events()
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(types.EventTypeConversionPause, events[0].Type)
+	paused, found := events[0].GetAttribute(types.AttributeKeyPaused)
+	suite.Require().True(found)
+	suite.Require().Equal("true", paused.Value)
+
+	_, err = msgServer.ConvertVouchers(suite.ctx, types.NewMsgConvertVouchers(address.String(), coins))
+	suite.Require().ErrorIs(err, types.ErrConversionPaused)
+	_, err = msgServer.ConvertCoin(suite.ctx, types.NewMsgConvertCoin(address.String(), coins[0]))
+	suite.Require().ErrorIs(err, types.ErrConversionPaused)
+	_, err = msgServer.TransferTokens(suite.ctx, types.NewMsgTransferTokens(address.String(), "to", coins))
+	suite.Require().ErrorIs(err, types.ErrConversionPaused)
+	suite.Require().Equal(coins[0], suite.GetBalance(address, types.IbcCroDenomDefaultValue))
+
+	// the refunds of the in-flight transfers are still processed
+	packet := channeltypes.NewPacket(nil, 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.ZeroHeight(), 0)
+	suite.app.CronosKeeper.OnRefundVouchers(suite.ctx, packet, coins, address.String())
+	suite.Require().True(suite.GetBalance(address, types.IbcCroDenomDefaultValue).IsZero())
+
+	// resume the conversions
+	suite.Require().NoError(suite.MintCoins(address, coins))
+	params.ConversionPaused = false
+	ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(suite.app.CronosKeeper.GetAuthority(), params))
+	suite.Require().NoError(err)
+	events = ctx.EventManager().Events()
+	suite.Require().Len(events, 1)
+	paused, found = events[0].GetAttribute(types.AttributeKeyPaused)
+	suite.Require().True(found)
+	suite.Require().Equal("false", paused.Value)
+
+	_, err = msgServer.ConvertVouchers(suite.ctx, types.NewMsgConvertVouchers(address.String(), coins))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.GetBalance(address, types.IbcCroDenomDefaultValue).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestTypedConversionEvents() {
+	suite.SetupTest()
+	address := sdk.AccAddress(suite.address.Bytes())
+	recipient := common.BytesToAddress(address).Hex()
+	coin := sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(100))
+	suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(coin.Add(coin))))
+
+	// parseTypedEvents returns the typed events of the given type emitted in the context
+	parseTypedEvents := func(ctx sdk.Context, eventType string) []proto.Message {
+		var msgs []proto.Message
+		for _, event := range ctx.EventManager().ABCIEvents() {
+			if event.Type != eventType {
+				continue
+			}
+			msg, err := sdk.ParseTypedEvent(event)
+			suite.Require().NoError(err)
+			msgs = append(msgs, msg)
+		}
+		return msgs
+	}
+
+	msgServer := cronosmodulekeeper.NewMsgServerImpl(suite.app.CronosKeeper)
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err := msgServer.ConvertVouchers(ctx, types.NewMsgConvertVouchers(address.String(), sdk.NewCoins(coin)))
+	suite.Require().NoError(err)
+	contract, found := suite.app.CronosKeeper.GetContractByDenom(suite.ctx, CorrectIbcDenom)
+	suite.Require().True(found)
+	events := parseTypedEvents(ctx, "cronos.EventConvertVouchers")
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(&types.EventConvertVouchers{
+		Sender:    address.String(),
+		Recipient: recipient,
+		Denom:     CorrectIbcDenom,
+		Contract:  contract.Hex(),
+		Amount:    "100",
+		Fee:       "0",
+	}, events[0])
+	// the legacy events are still emitted
+	suite.Require().NotEmpty(parseLegacyEvents(ctx, types.EventTypeConvertVouchers))
+
+	ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.ConvertCoin(ctx, types.NewMsgConvertCoin(address.String(), coin))
+	suite.Require().NoError(err)
+	events = parseTypedEvents(ctx, "cronos.EventConvertCoin")
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(&types.EventConvertCoin{
+		Sender:    address.String(),
+		Recipient: recipient,
+		Denom:     CorrectIbcDenom,
+		Contract:  contract.Hex(),
+		Amount:    "100",
+		Fee:       "0",
+	}, events[0])
+	suite.Require().NotEmpty(parseLegacyEvents(ctx, types.EventTypeConvertCoin))
+
+	// transfer the vouchers back through the mocked transfer keeper
+	suite.app.CronosKeeper = *cronosmodulekeeper.NewKeeper(
+		suite.app.EncodingConfig().Codec,
+		suite.app.GetKey(types.StoreKey),
+		suite.app.GetKey(types.MemStoreKey),
+		suite.app.GetObjKey(types.ObjectStoreKey),
+		suite.app.BankKeeper,
+		keepertest.IbcKeeperMock{},
+		suite.app.EvmKeeper,
+		suite.app.AccountKeeper,
+		suite.app.DistrKeeper,
+		suite.app.StakingKeeper,
+		suite.app.IBCKeeper.ChannelKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	msgServer = cronosmodulekeeper.NewMsgServerImpl(suite.app.CronosKeeper)
+	suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(coin)))
+	ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.TransferTokens(ctx, types.NewMsgTransferTokens(address.String(), "to", sdk.NewCoins(coin)))
+	suite.Require().NoError(err)
+	events = parseTypedEvents(ctx, "cronos.EventTransferTokens")
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(&types.EventTransferTokens{
+		Sender:    address.String(),
+		Recipient: "to",
+		Denom:     CorrectIbcDenom,
+		Contract:  contract.Hex(),
+		Amount:    "100",
+	}, events[0])
+	suite.Require().NotEmpty(parseLegacyEvents(ctx, types.EventTypeTransferTokens))
+}
+
+func (suite *KeeperTestSuite) TestTransferTokensDenom() {
+	testCases := []struct {
+		name       string
+		denom      string
+		channelId  string
+		expChannel string
+		expErr     error
+	}{
+		{"returning ibc token", CorrectIbcDenom, "", "channel-0", nil},
+		{"returning ibc token through its channel", CorrectIbcDenom, "channel-0", "channel-0", nil},
+		{
+			"returning ibc token through another channel", CorrectIbcDenom, "channel-1", "",
+			fmt.Errorf("voucher %s must be sent back through channel channel-0, not channel-1: invalid request", CorrectIbcDenom),
+		},
+		{"native token", CorrectCronosDenom, "channel-3", "channel-3", nil},
+		{"native token without channel", CorrectCronosDenom, "", "", errors.New("invalid channel id for ibc transfer of source token")},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			var transfers []*ibctransfertypes.MsgTransfer
+			suite.app.CronosKeeper = *cronosmodulekeeper.NewKeeper(
+				suite.app.EncodingConfig().Codec,
+				suite.app.GetKey(types.StoreKey),
+				suite.app.GetKey(types.MemStoreKey),
+				suite.app.GetObjKey(types.ObjectStoreKey),
+				suite.app.BankKeeper,
+				keepertest.RecordingIbcKeeperMock{Transfers: &transfers},
+				suite.app.EvmKeeper,
+				suite.app.AccountKeeper,
+				suite.app.DistrKeeper,
+				suite.app.StakingKeeper,
+				suite.app.IBCKeeper.ChannelKeeper,
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			)
+			msgServer := cronosmodulekeeper.NewMsgServerImpl(suite.app.CronosKeeper)
+
+			address := sdk.AccAddress(suite.address.Bytes())
+			coin := sdk.NewCoin(tc.denom, sdkmath.NewInt(100))
+			suite.SetChannel("channel-3", channeltypes.OPEN)
+			suite.app.CronosKeeper.SetAutoContractForDenom(suite.ctx, tc.denom, common.HexToAddress("0x11"))
+			suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(coin)))
+
+			msg := types.NewMsgTransferTokens(address.String(), "to", sdk.NewCoins(coin))
+			msg.ChannelId = tc.channelId
+			suite.Require().NoError(msg.ValidateBasic())
+			_, err := msgServer.TransferTokens(suite.ctx, msg)
+			if tc.expErr != nil {
+				suite.Require().EqualError(err, tc.expErr.Error())
+				suite.Require().Empty(transfers)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(transfers, 1)
+			// the transfer module resolves the trace of the voucher and strips its prefix
+			// when sent back through the channel it was received from
+			suite.Require().Equal(tc.expChannel, transfers[0].SourceChannel)
+			suite.Require().Equal(coin, transfers[0].Token)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTransferTokensOptions() {
+	testCases := []struct {
+		name             string
+		timeoutHeight    clienttypes.Height
+		timeoutTimestamp uint64
+		memo             string
+		defaultTimeout   bool
+	}{
+		{"default timeout", clienttypes.ZeroHeight(), 0, "", true},
+		{"timeout height", clienttypes.NewHeight(1, 1000), 0, "", false},
+		{"timeout timestamp with memo", clienttypes.ZeroHeight(), 1700000000000000000, "memo", false},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			var transfers []*ibctransfertypes.MsgTransfer
+			suite.app.CronosKeeper = *cronosmodulekeeper.NewKeeper(
+				suite.app.EncodingConfig().Codec,
+				suite.app.GetKey(types.StoreKey),
+				suite.app.GetKey(types.MemStoreKey),
+				suite.app.GetObjKey(types.ObjectStoreKey),
+				suite.app.BankKeeper,
+				keepertest.RecordingIbcKeeperMock{Transfers: &transfers},
+				suite.app.EvmKeeper,
+				suite.app.AccountKeeper,
+				suite.app.DistrKeeper,
+				suite.app.StakingKeeper,
+				suite.app.IBCKeeper.ChannelKeeper,
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			)
+			msgServer := cronosmodulekeeper.NewMsgServerImpl(suite.app.CronosKeeper)
+
+			address := sdk.AccAddress(suite.address.Bytes())
+			coin := sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(100))
+			suite.app.CronosKeeper.SetAutoContractForDenom(suite.ctx, coin.Denom, common.HexToAddress("0x11"))
+			suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(coin)))
+
+			msg := types.NewMsgTransferTokens(address.String(), "to", sdk.NewCoins(coin))
+			msg.ChannelId = "channel-0"
+			msg.TimeoutHeight = tc.timeoutHeight
+			msg.TimeoutTimestamp = tc.timeoutTimestamp
+			msg.Memo = tc.memo
+			suite.Require().NoError(msg.ValidateBasic())
+			_, err := msgServer.TransferTokens(suite.ctx, msg)
+			suite.Require().NoError(err)
+			suite.Require().Len(transfers, 1)
+
+			expTimestamp := tc.timeoutTimestamp
+			if tc.defaultTimeout {
+				expTimestamp = uint64(suite.ctx.BlockTime().UnixNano()) + suite.app.CronosKeeper.GetParams(suite.ctx).IbcTimeout
+			}
+			suite.Require().Equal(tc.timeoutHeight, transfers[0].TimeoutHeight)
+			suite.Require().Equal(expTimestamp, transfers[0].TimeoutTimestamp)
+			suite.Require().Equal(tc.memo, transfers[0].Memo)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertAndTransfer() {
+	testCases := []struct {
+		name         string
+		coin         sdk.Coin
+		transfer     bool
+		malleate     func()
+		failTransfer bool
+		expErr       error
+		expTransfer  sdk.Coin
+	}{
+		{
+			"convert and transfer a voucher", sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(100)), true,
+			func() {}, false, nil, sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(100)),
+		},
+		{
+			"the transfer is net of the conversion fee", sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(100)), true,
+			func() {
+				params := suite.app.CronosKeeper.GetParams(suite.ctx)
+				params.ConversionFees = []types.ConversionFee{{Denom: CorrectIbcDenom, BasisPoints: 1000}}
+				suite.Require().NoError(suite.app.CronosKeeper.SetParams(suite.ctx, params))
+			}, false, nil, sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(90)),
+		},
+		{
+			"convert and transfer the gas token", sdk.NewCoin(types.IbcCroDenomDefaultValue, sdkmath.NewInt(123)), true,
+			func() {}, false, nil, sdk.NewCoin(types.IbcCroDenomDefaultValue, sdkmath.NewInt(123)),
+		},
+		{
+			"convert only", sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(100)), false,
+			func() {}, false, nil, sdk.Coin{},
+		},
+		{
+			"closed channel", sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(100)), true,
+			func() { suite.SetChannel("channel-0", channeltypes.CLOSED) }, false, types.ErrChannelNotOpen, sdk.Coin{},
+		},
+		{
+			"the conversion is reverted when the transfer fails", sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(100)), true,
+			func() {}, true, errors.New("transfer failed"), sdk.Coin{},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			var transfers []*ibctransfertypes.MsgTransfer
+			var transferKeeper types.TransferKeeper = keepertest.RecordingIbcKeeperMock{Transfers: &transfers}
+			if tc.failTransfer {
+				transferKeeper = keepertest.FailingIbcKeeperMock{}
+			}
+			suite.app.CronosKeeper = *cronosmodulekeeper.NewKeeper(
+				suite.app.EncodingConfig().Codec,
+				suite.app.GetKey(types.StoreKey),
+				suite.app.GetKey(types.MemStoreKey),
+				suite.app.GetObjKey(types.ObjectStoreKey),
+				suite.app.BankKeeper,
+				transferKeeper,
+				suite.app.EvmKeeper,
+				suite.app.AccountKeeper,
+				suite.app.DistrKeeper,
+				suite.app.StakingKeeper,
+				suite.app.IBCKeeper.ChannelKeeper,
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			)
+			keeper := suite.app.CronosKeeper
+			params := keeper.GetParams(suite.ctx)
+			params.EnableAutoDeployment = true
+			suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+			tc.malleate()
+			msgServer := cronosmodulekeeper.NewMsgServerImpl(keeper)
+
+			address := sdk.AccAddress(suite.address.Bytes())
+			suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(tc.coin)))
+			evmDenom := suite.evmParam.EvmDenom
+			evmBalance := suite.GetBalance(address, evmDenom)
+
+			msg := types.NewMsgConvertAndTransfer(address.String(), sdk.NewCoins(tc.coin), "to", "")
+			msg.Transfer = tc.transfer
+			suite.Require().NoError(msg.ValidateBasic())
+			_, err := msgServer.ConvertAndTransfer(suite.ctx, msg)
+			if tc.expErr != nil {
+				if tc.failTransfer {
+					suite.Require().EqualError(err, tc.expErr.Error())
+				} else {
+					suite.Require().ErrorIs(err, tc.expErr)
+				}
+				// nothing is converted
+				suite.Require().Empty(transfers)
+				suite.Require().Equal(tc.coin, suite.GetBalance(address, tc.coin.Denom))
+				_, found := keeper.GetContractByDenom(suite.ctx, tc.coin.Denom)
+				suite.Require().False(found)
+				return
+			}
+			suite.Require().NoError(err)
+
+			if !tc.transfer {
+				suite.Require().Empty(transfers)
+				suite.Require().True(suite.GetBalance(address, tc.coin.Denom).IsZero())
+				contract, found := keeper.GetContractByDenom(suite.ctx, tc.coin.Denom)
+				suite.Require().True(found)
+				ret, err := keeper.CallModuleCRC21(suite.ctx, contract, "balanceOf", common.BytesToAddress(address.Bytes()))
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.coin.Amount.BigInt(), new(big.Int).SetBytes(ret))
+				return
+			}
+
+			suite.Require().Len(transfers, 1)
+			suite.Require().Equal(tc.expTransfer, transfers[0].Token)
+			suite.Require().Equal("channel-0", transfers[0].SourceChannel)
+			suite.Require().Equal(address.String(), transfers[0].Sender)
+			suite.Require().Equal("to", transfers[0].Receiver)
+			// the mocked transfer leaves the vouchers to the sender, no intermediate balance is left
+			suite.Require().Equal(tc.expTransfer, suite.GetBalance(address, tc.coin.Denom))
+			suite.Require().Equal(evmBalance, suite.GetBalance(address, evmDenom))
+			if contract, found := keeper.GetContractByDenom(suite.ctx, tc.coin.Denom); found {
+				ret, err := keeper.CallModuleCRC21(suite.ctx, contract, "balanceOf", common.BytesToAddress(address.Bytes()))
+				suite.Require().NoError(err)
+				suite.Require().Zero(new(big.Int).SetBytes(ret).Sign())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTransferTokensChannel() {
+	testCases := []struct {
+		name      string
+		denom     string
+		amount    sdkmath.Int
+		channelId string
+		malleate  func()
+		expErr    error
+	}{
+		{"missing channel", CorrectCronosDenom, sdkmath.NewInt(100), "channel-7", func() {}, types.ErrChannelNotFound},
+		{
+			"closed channel", CorrectIbcDenom, sdkmath.NewInt(100), "",
+			func() { suite.SetChannel("channel-0", channeltypes.CLOSED) }, types.ErrChannelNotOpen,
+		},
+		{
+			"closed channel, the gas token is not burned", "", sdkmath.NewIntFromBigInt(types.TenPowTen).MulRaw(123), "",
+			func() { suite.SetChannel("channel-0", channeltypes.CLOSED) }, types.ErrChannelNotOpen,
+		},
+		{
+			"channel in init state", CorrectCronosDenom, sdkmath.NewInt(100), "channel-7",
+			func() { suite.SetChannel("channel-7", channeltypes.INIT) }, types.ErrChannelNotOpen,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			var transfers []*ibctransfertypes.MsgTransfer
+			suite.app.CronosKeeper = *cronosmodulekeeper.NewKeeper(
+				suite.app.EncodingConfig().Codec,
+				suite.app.GetKey(types.StoreKey),
+				suite.app.GetKey(types.MemStoreKey),
+				suite.app.GetObjKey(types.ObjectStoreKey),
+				suite.app.BankKeeper,
+				keepertest.RecordingIbcKeeperMock{Transfers: &transfers},
+				suite.app.EvmKeeper,
+				suite.app.AccountKeeper,
+				suite.app.DistrKeeper,
+				suite.app.StakingKeeper,
+				suite.app.IBCKeeper.ChannelKeeper,
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			)
+			msgServer := cronosmodulekeeper.NewMsgServerImpl(suite.app.CronosKeeper)
+			tc.malleate()
+
+			denom := tc.denom
+			if denom == "" {
+				denom = suite.evmParam.EvmDenom
+			} else {
+				suite.app.CronosKeeper.SetAutoContractForDenom(suite.ctx, denom, common.HexToAddress("0x11"))
+			}
+			address := sdk.AccAddress(suite.address.Bytes())
+			coin := sdk.NewCoin(denom, tc.amount)
+			suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(coin)))
+			balance := suite.GetBalance(address, denom)
+
+			msg := types.NewMsgTransferTokens(address.String(), "to", sdk.NewCoins(coin))
+			msg.ChannelId = tc.channelId
+			suite.Require().NoError(msg.ValidateBasic())
+			_, err := msgServer.TransferTokens(suite.ctx, msg)
+			suite.Require().ErrorIs(err, tc.expErr)
+			suite.Require().Empty(transfers)
+			// the funds are untouched
+			suite.Require().Equal(balance, suite.GetBalance(address, denom))
+		})
+	}
+}
+
+// parseLegacyEvents returns the string attribute events of the given type emitted in the context
+func parseLegacyEvents(ctx sdk.Context, eventType string) []sdk.Event {
+	var events []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func (suite *KeeperTestSuite) TestMinConversionAmount() {
+	testCases := []struct {
+		name     string
+		malleate func(params *types.Params)
+		minimum  int64
+	}{
+		{"no minimum", func(*types.Params) {}, 0},
+		{"default minimum", func(params *types.Params) { params.MinConversionAmount = sdkmath.NewInt(100) }, 100},
+		{"minimum of the denom", func(params *types.Params) {
+			params.MinConversionAmount = sdkmath.NewInt(1000)
+			params.MinConversionAmounts = []types.ConversionMinimum{{Denom: CorrectIbcDenom, Amount: sdkmath.NewInt(100)}}
+		}, 100},
+		{"zero minimum of the denom", func(params *types.Params) {
+			params.MinConversionAmount = sdkmath.NewInt(1000)
+			params.MinConversionAmounts = []types.ConversionMinimum{{Denom: CorrectIbcDenom, Amount: sdkmath.ZeroInt()}}
+		}, 0},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			keeper := suite.app.CronosKeeper
+			address := sdk.AccAddress(suite.address.Bytes())
+			params := keeper.GetParams(suite.ctx)
+			params.EnableAutoDeployment = true
+			tc.malleate(&params)
+			suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+			suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(1000)))))
+			msgServer := cronosmodulekeeper.NewMsgServerImpl(keeper)
+
+			minimum := sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(tc.minimum))
+			if tc.minimum > 0 {
+				below := minimum.SubAmount(sdkmath.OneInt())
+				_, err := msgServer.ConvertVouchers(suite.ctx, types.NewMsgConvertVouchers(address.String(), sdk.NewCoins(below)))
+				suite.Require().ErrorIs(err, types.ErrAmountTooSmall)
+				// no state is written for the rejected conversion
+				_, found := keeper.GetContractByDenom(suite.ctx, CorrectIbcDenom)
+				suite.Require().False(found)
+				suite.Require().Equal(sdkmath.NewInt(1000), suite.GetBalance(address, CorrectIbcDenom).Amount)
+			} else {
+				minimum = minimum.AddAmount(sdkmath.OneInt())
+			}
+
+			_, err := msgServer.ConvertVouchers(suite.ctx, types.NewMsgConvertVouchers(address.String(), sdk.NewCoins(minimum)))
+			suite.Require().NoError(err)
+			converted := suite.GetBalance(address, CorrectIbcDenom)
+			suite.Require().Equal(sdkmath.NewInt(1000).Sub(minimum.Amount), converted.Amount)
+
+			// the coins converted with the registered contract have the same minimum
+			if tc.minimum > 0 {
+				_, err = msgServer.ConvertCoin(suite.ctx, types.NewMsgConvertCoin(address.String(), minimum.SubAmount(sdkmath.OneInt())))
+				suite.Require().ErrorIs(err, types.ErrAmountTooSmall)
+				suite.Require().Equal(converted, suite.GetBalance(address, CorrectIbcDenom))
+			}
+			_, err = msgServer.ConvertCoin(suite.ctx, types.NewMsgConvertCoin(address.String(), minimum))
+			suite.Require().NoError(err)
+			suite.Require().Equal(converted.Sub(minimum), suite.GetBalance(address, CorrectIbcDenom))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDenomNotConvertible() {
+	blocked := "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+
+	testCases := []struct {
+		name  string
+		denom func() string
+	}{
+		{"bond denom", func() string {
+			denom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+			suite.Require().NoError(err)
+			return denom
+		}},
+		{"blocklisted denom", func() string { return blocked }},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			keeper := suite.app.CronosKeeper
+			address := sdk.AccAddress(suite.address.Bytes())
+			params := keeper.GetParams(suite.ctx)
+			params.EnableAutoDeployment = true
+			params.ConversionBlocklist = []string{blocked}
+			suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+
+			coin := sdk.NewCoin(tc.denom(), sdkmath.NewInt(100))
+			allowed := sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(100))
+			suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(coin, allowed)))
+			balance := suite.GetBalance(address, coin.Denom)
+			msgServer := cronosmodulekeeper.NewMsgServerImpl(keeper)
+
+			_, err := msgServer.ConvertVouchers(suite.ctx, types.NewMsgConvertVouchers(address.String(), sdk.NewCoins(coin)))
+			suite.Require().ErrorIs(err, types.ErrDenomNotConvertible)
+			// nothing is escrowed when a denom of the batch is rejected
+			_, err = msgServer.ConvertVouchers(suite.ctx, types.NewMsgConvertVouchers(address.String(), sdk.NewCoins(allowed, coin)))
+			suite.Require().ErrorIs(err, types.ErrDenomNotConvertible)
+			suite.Require().Equal(allowed, suite.GetBalance(address, allowed.Denom))
+
+			// even with a contract registered for the denom
+			contract := common.BigToAddress(big.NewInt(0x1000))
+			suite.SetContractCode(contract)
+			suite.Require().NoError(keeper.SetExternalContractMapping(suite.ctx, coin.Denom, contract))
+			_, err = msgServer.ConvertCoin(suite.ctx, types.NewMsgConvertCoin(address.String(), coin))
+			suite.Require().ErrorIs(err, types.ErrDenomNotConvertible)
+			suite.Require().Equal(balance, suite.GetBalance(address, coin.Denom))
+
+			// the other denoms are converted
+			_, err = msgServer.ConvertVouchers(suite.ctx, types.NewMsgConvertVouchers(address.String(), sdk.NewCoins(allowed)))
+			suite.Require().NoError(err)
+			suite.Require().True(suite.GetBalance(address, allowed.Denom).IsZero())
+		})
+	}
+}
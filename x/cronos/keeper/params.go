@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"cosmossdk.io/errors"
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transferTypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
@@ -39,23 +40,55 @@ func (k Keeper) GetEvmParams(ctx sdk.Context) evmTypes.Params {
 	return k.evmKeeper.GetParams(ctx)
 }
 
-// GetSourceChannelID returns the channel id for an ibc voucher
+// GetDenomTrace returns the denom trace of an ibc voucher
 // The voucher has for format ibc/hash(path)
-func (k Keeper) GetSourceChannelID(ctx sdk.Context, ibcVoucherDenom string) (channelID string, err error) {
+func (k Keeper) GetDenomTrace(ctx sdk.Context, ibcVoucherDenom string) (transferTypes.DenomTrace, error) {
 	// remove the ibc
 	hash := strings.Split(ibcVoucherDenom, "/")
 	if len(hash) != 2 {
-		return "", errors.Wrapf(types.ErrIbcCroDenomInvalid, "%s is invalid", ibcVoucherDenom)
+		return transferTypes.DenomTrace{}, errors.Wrapf(types.ErrIbcCroDenomInvalid, "%s is invalid", ibcVoucherDenom)
 	}
 	hexDenomBytes, err := transferTypes.ParseHexHash(hash[1])
 	if err != nil {
-		return "", errors.Wrapf(types.ErrIbcCroDenomInvalid, "%s is invalid", ibcVoucherDenom)
+		return transferTypes.DenomTrace{}, errors.Wrapf(types.ErrIbcCroDenomInvalid, "%s is invalid", ibcVoucherDenom)
 	}
-	denomTrace, exists := k.transferKeeper.GetDenomTrace(ctx, hexDenomBytes)
+	denomTrace, exists := k.getDenomTrace(ctx, hexDenomBytes)
 	if !exists {
-		return "", errors.Wrapf(types.ErrIbcCroDenomInvalid, "%s is invalid", ibcVoucherDenom)
+		return transferTypes.DenomTrace{}, errors.Wrapf(types.ErrDenomTraceNotFound, "%s", ibcVoucherDenom)
+	}
+	return denomTrace, nil
+}
+
+// getDenomTrace looks up the denom trace in the object store before querying the transfer keeper.
+// The object store is reset at the end of every block and is branched with the context, so nothing
+// is shared between blocks or kept from a reverted tx. Only found traces are cached: a trace never
+// changes once set since the hash is derived from it, but a missing one can be created at any time.
+func (k Keeper) getDenomTrace(ctx sdk.Context, hash tmbytes.HexBytes) (transferTypes.DenomTrace, bool) {
+	if k.objStoreKey == nil {
+		return k.transferKeeper.GetDenomTrace(ctx, hash)
+	}
+	store := ctx.ObjectStore(k.objStoreKey)
+	key := types.DenomTraceCacheKey(hash)
+	if cached := store.Get(key); cached != nil {
+		return cached.(transferTypes.DenomTrace), true
+	}
+	denomTrace, exists := k.transferKeeper.GetDenomTrace(ctx, hash)
+	if exists {
+		store.Set(key, denomTrace)
 	}
+	return denomTrace, exists
+}
 
-	// the path has for format port/channelId
-	return strings.Split(denomTrace.Path, "/")[1], nil
+// GetSourceChannelID returns the channel id for an ibc voucher,
+// for multi-hop vouchers it's the channel the voucher has been received from
+func (k Keeper) GetSourceChannelID(ctx sdk.Context, ibcVoucherDenom string) (channelID string, err error) {
+	denomTrace, err := k.GetDenomTrace(ctx, ibcVoucherDenom)
+	if err != nil {
+		return "", err
+	}
+	channelID, err = types.GetSourceChannelFromTrace(denomTrace)
+	if err != nil {
+		return "", errors.Wrapf(types.ErrIbcCroDenomInvalid, "%s: %s", ibcVoucherDenom, err)
+	}
+	return channelID, nil
 }
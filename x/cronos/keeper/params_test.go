@@ -2,6 +2,12 @@ package keeper_test
 
 import (
 	"errors"
+	"fmt"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -10,6 +16,13 @@ import (
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 )
 
+const (
+	SingleHopIbcDenom    = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	MultiHopIbcDenom     = "ibc/8C2C70C47936CF18CD46D44B9C83AF54DDAA84EE47A69CC451E45B5DF6C87BB3"
+	MalformedTraceDenom  = "ibc/CCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCC"
+	UnknownTraceIbcDenom = "ibc/BAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"
+)
+
 func (suite *KeeperTestSuite) TestGetSourceChannelID() {
 	testCases := []struct {
 		name          string
@@ -31,6 +44,34 @@ func (suite *KeeperTestSuite) TestGetSourceChannelID() {
 				suite.Require().Equal(channelID, "channel-0")
 			},
 		},
+		{
+			"single hop ibc denom",
+			SingleHopIbcDenom,
+			nil,
+			func(channelID string) {
+				suite.Require().Equal("channel-0", channelID)
+			},
+		},
+		{
+			"multi hop ibc denom",
+			MultiHopIbcDenom,
+			nil,
+			func(channelID string) {
+				suite.Require().Equal("channel-1", channelID)
+			},
+		},
+		{
+			"unknown hash",
+			UnknownTraceIbcDenom,
+			types.ErrDenomTraceNotFound,
+			func(channelID string) {},
+		},
+		{
+			"malformed trace path",
+			MalformedTraceDenom,
+			types.ErrIbcCroDenomInvalid,
+			func(channelID string) {},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -41,17 +82,24 @@ func (suite *KeeperTestSuite) TestGetSourceChannelID() {
 				suite.app.EncodingConfig().Codec,
 				suite.app.GetKey(types.StoreKey),
 				suite.app.GetKey(types.MemStoreKey),
+				suite.app.GetObjKey(types.ObjectStoreKey),
 				suite.app.BankKeeper,
 				keepertest.IbcKeeperMock{},
 				suite.app.EvmKeeper,
 				suite.app.AccountKeeper,
+				suite.app.DistrKeeper,
+				suite.app.StakingKeeper,
+				suite.app.IBCKeeper.ChannelKeeper,
 				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 			)
 			suite.app.CronosKeeper = cronosKeeper
 
 			channelID, err := suite.app.CronosKeeper.GetSourceChannelID(suite.ctx, tc.ibcDenom)
 			if tc.expectedError != nil {
-				suite.Require().EqualError(err, tc.expectedError.Error())
+				// sentinel errors are matched by type, the others by message
+				if !errors.Is(err, tc.expectedError) {
+					suite.Require().EqualError(err, tc.expectedError.Error())
+				}
 			} else {
 				suite.Require().NoError(err)
 				tc.postCheck(channelID)
@@ -59,3 +107,98 @@ func (suite *KeeperTestSuite) TestGetSourceChannelID() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestDenomTraceCache() {
+	suite.SetupTest()
+	lookups := 0
+	cronosKeeper := *cronosmodulekeeper.NewKeeper(
+		suite.app.EncodingConfig().Codec,
+		suite.app.GetKey(types.StoreKey),
+		suite.app.GetKey(types.MemStoreKey),
+		suite.app.GetObjKey(types.ObjectStoreKey),
+		suite.app.BankKeeper,
+		keepertest.CountingIbcKeeperMock{Lookups: &lookups},
+		suite.app.EvmKeeper,
+		suite.app.AccountKeeper,
+		suite.app.DistrKeeper,
+		suite.app.StakingKeeper,
+		suite.app.IBCKeeper.ChannelKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// found traces are looked up once
+	for i := 0; i < 100; i++ {
+		channelID, err := cronosKeeper.GetSourceChannelID(suite.ctx, MultiHopIbcDenom)
+		suite.Require().NoError(err)
+		suite.Require().Equal("channel-1", channelID)
+	}
+	suite.Require().Equal(1, lookups)
+
+	// missing traces are not cached
+	for i := 0; i < 2; i++ {
+		_, err := cronosKeeper.GetSourceChannelID(suite.ctx, UnknownTraceIbcDenom)
+		suite.Require().ErrorIs(err, types.ErrDenomTraceNotFound)
+	}
+	suite.Require().Equal(3, lookups)
+
+	// the lookups of a discarded context are not kept
+	cacheCtx, _ := suite.ctx.CacheContext()
+	_, err := cronosKeeper.GetSourceChannelID(cacheCtx, SingleHopIbcDenom)
+	suite.Require().NoError(err)
+	_, err = cronosKeeper.GetSourceChannelID(suite.ctx, SingleHopIbcDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(5, lookups)
+
+	// the cache is reset by the commit of the block
+	_, err = suite.app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: suite.app.LastBlockHeight() + 1})
+	suite.Require().NoError(err)
+	_, err = suite.app.Commit()
+	suite.Require().NoError(err)
+	ctx := suite.app.NewContext(true)
+	_, err = cronosKeeper.GetSourceChannelID(ctx, MultiHopIbcDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(6, lookups)
+}
+
+// BenchmarkDenomTraceLookup benchmarks the resolution of the source channel of a batch
+// of 100 conversions of the same voucher, with and without the denom trace cache
+func BenchmarkDenomTraceLookup(b *testing.B) {
+	for _, cached := range []bool{false, true} {
+		b.Run(fmt.Sprintf("cached=%t", cached), func(b *testing.B) {
+			key := storetypes.NewKVStoreKey(types.StoreKey)
+			okey := storetypes.NewObjectStoreKey(types.ObjectStoreKey)
+			testCtx := testutil.DefaultContextWithObjectStore(b, key, storetypes.NewTransientStoreKey("transient_test"), okey)
+			var objStoreKey storetypes.StoreKey
+			if cached {
+				objStoreKey = okey
+			}
+			lookups := 0
+			cronosKeeper := cronosmodulekeeper.NewKeeper(
+				nil,
+				key,
+				nil,
+				objStoreKey,
+				nil,
+				keepertest.CountingIbcKeeperMock{Lookups: &lookups},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				// every batch is executed in its own block
+				ctx, _ := testCtx.Ctx.CacheContext()
+				for j := 0; j < 100; j++ {
+					if _, err := cronosKeeper.GetSourceChannelID(ctx, MultiHopIbcDenom); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+			b.ReportMetric(float64(lookups)/float64(b.N), "lookups/op")
+		})
+	}
+}
@@ -33,9 +33,9 @@ func (k Keeper) HasPermission(ctx sdk.Context, accounts []sdk.AccAddress, permis
 	if permissionsToCheck == 0 {
 		return true
 	}
-	admin := k.GetParams(ctx).CronosAdmin
+	params := k.GetParams(ctx)
 	for _, account := range accounts {
-		if admin == account.String() {
+		if params.IsCronosAdmin(account.String()) {
 			return true
 		}
 		permission := k.GetPermissions(ctx, account)
@@ -61,3 +61,28 @@ func (suite *KeeperTestSuite) TestHasPermissions() {
 	suite.Require().Equal(true, keeper.HasPermission(suite.ctx, cosmosAddress, CanChangeTokenMapping))
 	suite.Require().Equal(true, keeper.HasPermission(suite.ctx, cosmosAddress, CanTurnBridge))
 }
+
+func (suite *KeeperTestSuite) TestHasPermissionsMultipleAdmins() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+
+	admins := []sdk.AccAddress{
+		sdk.AccAddress([]byte("first_cronos_admin__")),
+		sdk.AccAddress([]byte("second_cronos_admin_")),
+	}
+	other := sdk.AccAddress([]byte("not_a_cronos_admin__"))
+
+	params := keeper.GetParams(suite.ctx)
+	params.CronosAdmins = []string{admins[0].String(), admins[1].String()}
+	suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+
+	for _, admin := range admins {
+		suite.Require().True(keeper.HasPermission(suite.ctx, []sdk.AccAddress{admin}, CanChangeTokenMapping))
+		suite.Require().True(keeper.HasPermission(suite.ctx, []sdk.AccAddress{admin}, All))
+	}
+	suite.Require().False(keeper.HasPermission(suite.ctx, []sdk.AccAddress{other}, CanChangeTokenMapping))
+
+	// duplicated admins are rejected
+	params.CronosAdmins = []string{admins[0].String(), admins[0].String()}
+	suite.Require().Error(keeper.SetParams(suite.ctx, params))
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+)
+
+// quotaEpoch returns the epoch of the current block, the conversion quotas are reset at every new epoch
+func quotaEpoch(ctx sdk.Context, params types.Params) uint64 {
+	return uint64(ctx.BlockHeight()) / params.QuotaEpochBlocks
+}
+
+// GetConvertedAmount returns the amount of the denom converted within the current quota epoch
+func (k Keeper) GetConvertedAmount(ctx sdk.Context, denom string) sdkmath.Int {
+	params := k.GetParams(ctx)
+	if params.QuotaEpochBlocks == 0 {
+		return sdkmath.ZeroInt()
+	}
+	epoch, amount := k.getConvertedAmount(ctx, denom)
+	if epoch != quotaEpoch(ctx, params) {
+		return sdkmath.ZeroInt()
+	}
+	return amount
+}
+
+// getConvertedAmount returns the stored converted amount of the denom and the epoch it's accumulated in,
+// the value is the big endian epoch followed by the amount.
+func (k Keeper) getConvertedAmount(ctx sdk.Context, denom string) (uint64, sdkmath.Int) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ConvertedAmountKey(denom))
+	if len(bz) < 8 {
+		return 0, sdkmath.ZeroInt()
+	}
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(bz[8:]); err != nil {
+		panic(err)
+	}
+	return binary.BigEndian.Uint64(bz[:8]), amount
+}
+
+// setConvertedAmount set the converted amount of the denom within the epoch
+func (k Keeper) setConvertedAmount(ctx sdk.Context, denom string, epoch uint64, amount sdkmath.Int) {
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.ConvertedAmountKey(denom), append(sdk.Uint64ToBigEndian(epoch), bz...))
+}
+
+// consumeConversionQuota records the conversion of the coin in the current epoch, it fails with ErrQuotaExceeded
+// if the denom has a quota and the coin exceeds the remaining allowance, the denoms without a quota are not limited.
+func (k Keeper) consumeConversionQuota(ctx sdk.Context, coin sdk.Coin) error {
+	params := k.GetParams(ctx)
+	quota, found := params.GetConversionQuota(coin.Denom)
+	if !found {
+		return nil
+	}
+	epoch := quotaEpoch(ctx, params)
+	storedEpoch, converted := k.getConvertedAmount(ctx, coin.Denom)
+	if storedEpoch != epoch {
+		converted = sdkmath.ZeroInt()
+	}
+	total := converted.Add(coin.Amount)
+	if total.GT(quota) {
+		// the quota can be lowered below the amount already converted
+		remaining := sdkmath.MaxInt(quota.Sub(converted), sdkmath.ZeroInt())
+		return errors.Wrapf(types.ErrQuotaExceeded, "converting %s exceeds the quota of the epoch, remaining allowance %s%s",
+			coin, remaining, coin.Denom)
+	}
+	k.setConvertedAmount(ctx, coin.Denom, epoch, total)
+	return nil
+}
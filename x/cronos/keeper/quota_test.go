@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+)
+
+func (suite *KeeperTestSuite) TestConversionQuota() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+	address := sdk.AccAddress(suite.address.Bytes())
+
+	params := keeper.GetParams(suite.ctx)
+	params.EnableAutoDeployment = true
+	params.QuotaEpochBlocks = 10
+	params.ConversionQuotas = []types.ConversionQuota{{Denom: CorrectIbcDenom, Amount: sdkmath.NewInt(100)}}
+	suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+
+	convert := func(ctx sdk.Context, coins ...sdk.Coin) error {
+		suite.Require().NoError(suite.MintCoins(address, coins))
+		cacheCtx, commit := ctx.CacheContext()
+		if err := keeper.ConvertVouchersToEvmCoins(cacheCtx, address.String(), coins); err != nil {
+			return err
+		}
+		commit()
+		return nil
+	}
+
+	ctx := suite.ctx.WithBlockHeight(20)
+	suite.Require().NoError(convert(ctx, sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(60))))
+	suite.Require().Equal("60", keeper.GetConvertedAmount(ctx, CorrectIbcDenom).String())
+
+	err := convert(ctx, sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(41)))
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+	suite.Require().Contains(err.Error(), "remaining allowance 40"+CorrectIbcDenom)
+	suite.Require().Equal("60", keeper.GetConvertedAmount(ctx, CorrectIbcDenom).String())
+
+	// exactly the remaining allowance
+	suite.Require().NoError(convert(ctx.WithBlockHeight(29), sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(40))))
+	suite.Require().ErrorIs(convert(ctx.WithBlockHeight(29), sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(1))), types.ErrQuotaExceeded)
+
+	// the denoms without a quota are not limited
+	suite.Require().NoError(convert(ctx, sdk.NewCoin(types.IbcCroDenomDefaultValue, sdkmath.NewInt(1000))))
+
+	// the quota is reset at the next epoch
+	ctx = ctx.WithBlockHeight(30)
+	suite.Require().True(keeper.GetConvertedAmount(ctx, CorrectIbcDenom).IsZero())
+	suite.Require().NoError(convert(ctx, sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(100))))
+
+	// a lowered quota leaves no allowance
+	params.ConversionQuotas[0].Amount = sdkmath.NewInt(50)
+	suite.Require().NoError(keeper.SetParams(ctx, params))
+	err = convert(ctx, sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(1)))
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+	suite.Require().Contains(err.Error(), "remaining allowance 0"+CorrectIbcDenom)
+}
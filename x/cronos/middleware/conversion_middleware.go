@@ -11,6 +11,7 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	cronoskeeper "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 )
 
 // IBCConversionModule implements the ICS26 interface.
@@ -100,16 +101,32 @@ func (im IBCConversionModule) OnRecvPacket(
 	relayer sdk.AccAddress,
 ) exported.Acknowledgement {
 	ack := im.app.OnRecvPacket(ctx, packet, relayer)
-	if ack.Success() {
+	// the packet forward middleware doesn't return the acknowledgement of the forwarded packets,
+	// it's written asynchronously once the next hop acknowledges the forwarded packet
+	if ack != nil && ack.Success() {
 		data, err := im.getFungibleTokenPacketData(packet)
 		if err != nil {
 			return channeltypes.NewErrorAcknowledgement(errors.Wrap(sdkerrors.ErrUnknownRequest,
 				"cannot unmarshal ICS-20 transfer packet data in middleware"))
 		}
+		if types.IsForwardMemo(data.Memo) {
+			// the vouchers are forwarded to the next hop by the packet forward middleware, they are left
+			// untouched and converted by the chain the packet terminates at
+			return ack
+		}
 		denom := im.getIbcDenomFromPacketAndData(packet, data)
+		opts, err := types.ParseAutoConvertMemo(data.Memo)
+		if err != nil {
+			// the malformed options are ignored, the vouchers are converted like without a memo
+			im.cronoskeeper.Logger(ctx).Info("invalid memo, convert the vouchers to the receiver", "receiver", data.Receiver, "error", err)
+		} else if opts != nil && opts.AutoConvert {
+			// the packet always succeeds, the vouchers are kept by the receiver if the conversion fails
+			im.autoConvertVouchers(ctx, data, denom, opts)
+			return ack
+		}
 		// Check if it can be converted
 		if im.canBeConverted(ctx, denom) {
-			err = im.convertVouchers(ctx, data, denom, false)
+			err = im.convertVouchers(ctx, packet, data, denom, false)
 			if err != nil {
 				return channeltypes.NewErrorAcknowledgement(err)
 			}
@@ -141,7 +158,7 @@ func (im IBCConversionModule) OnAcknowledgementPacket(
 			}
 			denom := im.getIbcDenomFromDataForRefund(data)
 			if im.canBeConverted(ctx, denom) {
-				return im.convertVouchers(ctx, data, denom, true)
+				return im.convertVouchers(ctx, packet, data, denom, true)
 			}
 		}
 	}
@@ -164,7 +181,7 @@ func (im IBCConversionModule) OnTimeoutPacket(
 		}
 		denom := im.getIbcDenomFromDataForRefund(data)
 		if im.canBeConverted(ctx, denom) {
-			return im.convertVouchers(ctx, data, denom, true)
+			return im.convertVouchers(ctx, packet, data, denom, true)
 		}
 	}
 	return err
@@ -179,7 +196,13 @@ func (im IBCConversionModule) getFungibleTokenPacketData(packet channeltypes.Pac
 	return data, nil
 }
 
-func (im IBCConversionModule) convertVouchers(ctx sdk.Context, data transferTypes.FungibleTokenPacketData, denom string, isSender bool) error {
+func (im IBCConversionModule) convertVouchers(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transferTypes.FungibleTokenPacketData,
+	denom string,
+	isSender bool,
+) error {
 	// parse the transfer amount
 	transferAmount, ok := sdkmath.NewIntFromString(data.Amount)
 	if !ok {
@@ -188,13 +211,28 @@ func (im IBCConversionModule) convertVouchers(ctx sdk.Context, data transferType
 	}
 	token := sdk.NewCoin(denom, transferAmount)
 	if isSender {
-		im.cronoskeeper.OnRecvVouchers(ctx, sdk.NewCoins(token), data.Sender)
+		im.cronoskeeper.OnRefundVouchers(ctx, packet, sdk.NewCoins(token), data.Sender)
 	} else {
 		im.cronoskeeper.OnRecvVouchers(ctx, sdk.NewCoins(token), data.Receiver)
 	}
 	return nil
 }
 
+func (im IBCConversionModule) autoConvertVouchers(
+	ctx sdk.Context,
+	data transferTypes.FungibleTokenPacketData,
+	denom string,
+	opts *types.AutoConvertOptions,
+) {
+	// the amount is already validated by the transfer module
+	transferAmount, _ := sdkmath.NewIntFromString(data.Amount)
+	tokens := sdk.NewCoins(sdk.NewCoin(denom, transferAmount))
+	if err := im.cronoskeeper.OnRecvVouchersWithMemo(ctx, tokens, data.Receiver, opts); err != nil {
+		im.cronoskeeper.Logger(ctx).Info("memo conversion failed, keep the vouchers", "receiver", data.Receiver, "error", err)
+		ctx.EventManager().EmitEvent(types.NewAutoConvertFallbackEvent(data.Receiver, tokens, err.Error()))
+	}
+}
+
 func (im IBCConversionModule) canBeConverted(ctx sdk.Context, denom string) bool {
 	params := im.cronoskeeper.GetParams(ctx)
 	if denom == params.IbcCroDenom {
@@ -0,0 +1,43 @@
+package v3
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// LegacyKeyPrefixContractToDenom is the prefix of the contract to denom reverse index keyed by the raw contract
+// address in the consensus version 2
+var LegacyKeyPrefixContractToDenom = []byte{3}
+
+// Migrate migrates the x/cronos module state from the consensus version 2 to
+// version 3. Specifically, it moves the contract to denom reverse index to a new
+// prefix where the contract addresses are length prefixed, the denom to contract
+// mappings are kept as is. The entries are moved in the order of the keys and
+// removed from the legacy prefix, so running it again is a no-op.
+func Migrate(store storetypes.KVStore) error {
+	legacyStore := prefix.NewStore(store, LegacyKeyPrefixContractToDenom)
+
+	// the store can't be written while iterating
+	var keys, values [][]byte
+	iter := legacyStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+		values = append(values, iter.Value())
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for i, key := range keys {
+		if len(key) != common.AddressLength {
+			return fmt.Errorf("invalid contract address in the reverse index: %X", key)
+		}
+		store.Set(types.ContractToDenomKey(key), values[i])
+		legacyStore.Delete(key)
+	}
+	return nil
+}
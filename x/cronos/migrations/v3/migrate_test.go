@@ -0,0 +1,70 @@
+package v3_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	v3 "github.com/crypto-org-chain/cronos/v2/x/cronos/migrations/v3"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("test"))
+	store := ctx.KVStore(storeKey)
+
+	// seed the version 2 layout
+	mappings := []types.TokenMapping{
+		{Denom: "ibc/6B5A664BF0AF4F71B2F0BAA33141E2F1321242FBD5D19762F541EC971ACB0865", Contract: "0x0000000000000000000000000000000000000001"},
+		{Denom: "gravity0x0000000000000000000000000000000000000000", Contract: "0x1400000000000000000000000000000000000002"},
+		{Denom: "cronos0x0000000000000000000000000000000000000003", Contract: "0x0000000000000000000000000000000000000003"},
+	}
+	for i, m := range mappings {
+		contract := common.HexToAddress(m.Contract)
+		if i%2 == 0 {
+			store.Set(types.DenomToExternalContractKey(m.Denom), contract.Bytes())
+		} else {
+			store.Set(types.DenomToAutoContractKey(m.Denom), contract.Bytes())
+		}
+		store.Set(append(v3.LegacyKeyPrefixContractToDenom, contract.Bytes()...), []byte(m.Denom))
+	}
+
+	require.NoError(t, v3.Migrate(store))
+
+	// the legacy reverse index is removed
+	iter := prefix.NewStore(store, v3.LegacyKeyPrefixContractToDenom).Iterator(nil, nil)
+	require.False(t, iter.Valid())
+	require.NoError(t, iter.Close())
+
+	checkMappings := func() {
+		for i, m := range mappings {
+			contract := common.HexToAddress(m.Contract)
+			require.Equal(t, m.Denom, string(store.Get(types.ContractToDenomKey(contract.Bytes()))))
+			forwardKey := types.DenomToExternalContractKey(m.Denom)
+			if i%2 == 1 {
+				forwardKey = types.DenomToAutoContractKey(m.Denom)
+			}
+			require.Equal(t, contract.Bytes(), store.Get(forwardKey))
+		}
+		count := 0
+		iter := prefix.NewStore(store, types.KeyPrefixContractToDenom).Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			count++
+		}
+		require.NoError(t, iter.Close())
+		require.Equal(t, len(mappings), count)
+	}
+	checkMappings()
+
+	// idempotent
+	require.NoError(t, v3.Migrate(store))
+	checkMappings()
+
+	// malformed legacy key
+	store.Set(append(v3.LegacyKeyPrefixContractToDenom, 0x01), []byte("denom"))
+	require.Error(t, v3.Migrate(store))
+}
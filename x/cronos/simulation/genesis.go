@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 )
@@ -18,6 +19,7 @@ const (
 	cronosAdminKey          = "cronos_admin"
 	enableAutoDeploymentKey = "enable_auto_deployment"
 	maxCallbackGasKey       = "max_callback_gas"
+	externalContractsKey    = "external_contracts"
 )
 
 func GenIbcCroDenom(r *rand.Rand) string {
@@ -45,6 +47,21 @@ func GenMaxCallbackGas(r *rand.Rand) uint64 {
 	return maxCallbackGas
 }
 
+// GenExternalContracts generates up to 3 mappings of random ibc denoms to random contract addresses
+func GenExternalContracts(r *rand.Rand) []types.TokenMapping {
+	n := r.Intn(4)
+	mappings := make([]types.TokenMapping, 0, n)
+	for i := 0; i < n; i++ {
+		contract := make([]byte, common.AddressLength)
+		r.Read(contract)
+		mappings = append(mappings, types.TokenMapping{
+			Denom:    GenIbcCroDenom(r),
+			Contract: common.BytesToAddress(contract).Hex(),
+		})
+	}
+	return mappings
+}
+
 // RandomizedGenState generates a random GenesisState for the cronos module
 func RandomizedGenState(simState *module.SimulationState) {
 	// cronos params
@@ -54,6 +71,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		cronosAdmin          string
 		enableAutoDeployment bool
 		maxCallbackGas       uint64
+		externalContracts    []types.TokenMapping
 	)
 
 	simState.AppParams.GetOrGenerate(
@@ -77,14 +95,19 @@ func RandomizedGenState(simState *module.SimulationState) {
 	)
 
 	simState.AppParams.GetOrGenerate(
-		maxCallbackGasKey, &ibcTimeout, simState.Rand,
-		func(r *rand.Rand) { maxCallbackGas = GenIbcTimeout(r) },
+		maxCallbackGasKey, &maxCallbackGas, simState.Rand,
+		func(r *rand.Rand) { maxCallbackGas = GenMaxCallbackGas(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		externalContractsKey, &externalContracts, simState.Rand,
+		func(r *rand.Rand) { externalContracts = GenExternalContracts(r) },
 	)
 
-	params := types.NewParams(ibcCroDenom, ibcTimeout, cronosAdmin, enableAutoDeployment, maxCallbackGas)
+	params := types.NewParams(ibcCroDenom, ibcTimeout, []string{cronosAdmin}, enableAutoDeployment, maxCallbackGas, false)
 	cronosGenesis := &types.GenesisState{
 		Params:            params,
-		ExternalContracts: nil,
+		ExternalContracts: externalContracts,
 		AutoContracts:     nil,
 	}
 
@@ -3,6 +3,7 @@ package simulation
 import (
 	"errors"
 	"math/rand"
+	"slices"
 
 	errorsmod "cosmossdk.io/errors"
 	simappparams "cosmossdk.io/simapp/params"
@@ -12,7 +13,6 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
-	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
@@ -21,18 +21,28 @@ import (
 const (
 	/* #nosec */
 	OpWeightMsgUpdateTokenMapping = "op_weight_msg_update_token_mapping"
+	/* #nosec */
+	OpWeightMsgConvertVouchers = "op_weight_msg_convert_vouchers"
+	/* #nosec */
+	OpWeightMsgConvertCoin = "op_weight_msg_convert_coin"
 )
 
 const (
 	WeightMsgUpdateTokenMapping = 50
+	WeightMsgConvertVouchers    = 50
+	WeightMsgConvertCoin        = 50
 )
 
-// WeightedOperations generate SimulateUpdateTokenMapping operation.
+// WeightedOperations generate SimulateUpdateTokenMapping, SimulateConvertVouchers and SimulateConvertCoin operations.
 func WeightedOperations(
 	appParams simtypes.AppParams, cdc codec.JSONCodec,
 	ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper,
 ) simulation.WeightedOperations {
-	var weightMsgUpdateTokenMapping int
+	var (
+		weightMsgUpdateTokenMapping int
+		weightMsgConvertVouchers    int
+		weightMsgConvertCoin        int
+	)
 
 	appParams.GetOrGenerate(OpWeightMsgUpdateTokenMapping, &weightMsgUpdateTokenMapping, nil,
 		func(_ *rand.Rand) {
@@ -40,11 +50,31 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(OpWeightMsgConvertVouchers, &weightMsgConvertVouchers, nil,
+		func(_ *rand.Rand) {
+			weightMsgConvertVouchers = WeightMsgConvertVouchers
+		},
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgConvertCoin, &weightMsgConvertCoin, nil,
+		func(_ *rand.Rand) {
+			weightMsgConvertCoin = WeightMsgConvertCoin
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgUpdateTokenMapping,
 			SimulateUpdateTokenMapping(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgConvertVouchers,
+			SimulateConvertVouchers(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgConvertCoin,
+			SimulateConvertCoin(ak, bk, k),
+		),
 	}
 }
 
@@ -54,12 +84,12 @@ func SimulateUpdateTokenMapping(ak types.AccountKeeper, bk types.BankKeeper, k *
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 		accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		cronosAdmin := k.GetParams(ctx).CronosAdmin
+		params := k.GetParams(ctx)
 		var simAccount simtypes.Account
 
 		if r.Intn(2) > 0 {
 			var found bool
-			simAccount, found = findCronosAdmin(accs, cronosAdmin)
+			simAccount, found = findCronosAdmin(accs, params.CronosAdmins)
 			if !found {
 				simAccount, _ = simtypes.RandomAcc(r, accs)
 			}
@@ -68,9 +98,12 @@ func SimulateUpdateTokenMapping(ak types.AccountKeeper, bk types.BankKeeper, k *
 		}
 
 		denom := GenIbcCroDenom(r)
-		contractBytes := make([]byte, 20)
-		r.Read(contractBytes)
-		contract := common.BytesToAddress(contractBytes).String()
+		// the mapped contract must have code deployed
+		contractAddr, err := k.DeployModuleCRC21(ctx, denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateTokenMapping, "unable to deploy the contract"), nil, err
+		}
+		contract := contractAddr.String()
 		expendable := bk.SpendableCoins(ctx, simAccount.Address)
 
 		msg := types.NewMsgUpdateTokenMapping(simAccount.Address.String(), denom, contract, "", 0)
@@ -90,19 +123,118 @@ func SimulateUpdateTokenMapping(ak types.AccountKeeper, bk types.BankKeeper, k *
 		}
 
 		oper, ops, err := simulation.GenAndDeliverTxWithRandFees(txCtx)
-		if simAccount.Address.String() != cronosAdmin && errors.Is(err, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "msg sender is not authorized")) {
+		if !params.IsCronosAdmin(simAccount.Address.String()) && errors.Is(err, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "msg sender is not authorized")) {
 			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unauthorized tx should fail"), nil, nil
 		}
 		return oper, ops, err
 	}
 }
 
-func findCronosAdmin(accs []simtypes.Account, cronosAdmin string) (simtypes.Account, bool) {
-	found := false
+// SimulateConvertVouchers generate mocked MsgConvertVouchers message with a random subset of the convertible
+// coins of a random account, apply the message and assert the results.
+func SimulateConvertVouchers(ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if k.GetParams(ctx).ConversionPaused {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConvertVouchers, "conversions are paused"), nil, nil
+		}
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		coins := simtypes.RandSubsetCoins(r, convertibleCoins(ctx, k, spendable, true))
+		if coins.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConvertVouchers, "no convertible coins"), nil, nil
+		}
+
+		msg := types.NewMsgConvertVouchers(simAccount.Address.String(), coins)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: coins,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateConvertCoin generate mocked MsgConvertCoin message with a random amount of a convertible coin of a random
+// account, apply the message and assert the results.
+func SimulateConvertCoin(ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if k.GetParams(ctx).ConversionPaused {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConvertCoin, "conversions are paused"), nil, nil
+		}
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		convertible := convertibleCoins(ctx, k, spendable, false)
+		if convertible.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConvertCoin, "no convertible coins"), nil, nil
+		}
+		coin := convertible[r.Intn(len(convertible))]
+		amount, err := simtypes.RandPositiveInt(r, coin.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConvertCoin, "unable to generate the amount"), nil, err
+		}
+		coin.Amount = amount
+
+		msg := types.NewMsgConvertCoin(simAccount.Address.String(), coin)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(coin),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// convertibleCoins returns the coins which can be converted to crc20 tokens, they must be mapped to a contract
+// unless the auto deployment is enabled, the ibc cro is included only if withIbcCro is set as it's converted to
+// the gas token.
+func convertibleCoins(ctx sdk.Context, k *keeper.Keeper, coins sdk.Coins, withIbcCro bool) sdk.Coins {
+	params := k.GetParams(ctx)
+	var convertible sdk.Coins
+	for _, coin := range coins {
+		if coin.Denom == params.IbcCroDenom {
+			if withIbcCro {
+				convertible = append(convertible, coin)
+			}
+			continue
+		}
+		if !types.IsValidCoinDenom(coin.Denom) {
+			continue
+		}
+		if _, found := k.GetContractByDenom(ctx, coin.Denom); found || (params.EnableAutoDeployment && params.IsAutoDeployAllowed(coin.Denom)) {
+			convertible = append(convertible, coin)
+		}
+	}
+	return convertible
+}
+
+func findCronosAdmin(accs []simtypes.Account, cronosAdmins []string) (simtypes.Account, bool) {
 	for _, acc := range accs {
-		if acc.Address.String() == cronosAdmin {
-			found = true
-			return acc, found
+		if slices.Contains(cronosAdmins, acc.Address.String()) {
+			return acc, true
 		}
 	}
 	return simtypes.Account{}, false
@@ -27,6 +27,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateTokenMapping{},
 		&MsgTurnBridge{},
 		&MsgUpdatePermissions{},
+		&MsgConvertCoin{},
+		&MsgRedeployContract{},
+		&MsgConvertAndTransfer{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
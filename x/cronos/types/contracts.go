@@ -40,7 +40,9 @@ type CompiledContract struct {
 	Bin ByteString
 }
 
-const EVMModuleName = "cronos-evm"
+const (
+	EVMModuleName = "cronos-evm"
+)
 
 var (
 	//go:embed contracts/ModuleCRC20.json
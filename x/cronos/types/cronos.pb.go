@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -23,14 +25,71 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ConversionDirection defines the direction of a conversion between native tokens and CRC20 tokens
+type ConversionDirection int32
+
+const (
+	CONVERSION_DIRECTION_UNSPECIFIED ConversionDirection = 0
+	// native tokens converted to CRC20 tokens
+	CONVERSION_DIRECTION_TO_CRC20 ConversionDirection = 1
+	// CRC20 tokens converted back to native tokens
+	CONVERSION_DIRECTION_TO_NATIVE ConversionDirection = 2
+)
+
+var ConversionDirection_name = map[int32]string{
+	0: "CONVERSION_DIRECTION_UNSPECIFIED",
+	1: "CONVERSION_DIRECTION_TO_CRC20",
+	2: "CONVERSION_DIRECTION_TO_NATIVE",
+}
+
+var ConversionDirection_value = map[string]int32{
+	"CONVERSION_DIRECTION_UNSPECIFIED": 0,
+	"CONVERSION_DIRECTION_TO_CRC20":    1,
+	"CONVERSION_DIRECTION_TO_NATIVE":   2,
+}
+
+func (x ConversionDirection) String() string {
+	return proto.EnumName(ConversionDirection_name, int32(x))
+}
+
+func (ConversionDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{0}
+}
+
 // Params defines the parameters for the cronos module.
 type Params struct {
 	IbcCroDenom string `protobuf:"bytes,1,opt,name=ibc_cro_denom,json=ibcCroDenom,proto3" json:"ibc_cro_denom,omitempty" yaml:"ibc_cro_denom,omitempty"`
 	IbcTimeout  uint64 `protobuf:"varint,2,opt,name=ibc_timeout,json=ibcTimeout,proto3" json:"ibc_timeout,omitempty"`
-	// the admin address who can update token mapping
-	CronosAdmin          string `protobuf:"bytes,3,opt,name=cronos_admin,json=cronosAdmin,proto3" json:"cronos_admin,omitempty"`
-	EnableAutoDeployment bool   `protobuf:"varint,4,opt,name=enable_auto_deployment,json=enableAutoDeployment,proto3" json:"enable_auto_deployment,omitempty"`
-	MaxCallbackGas       uint64 `protobuf:"varint,5,opt,name=max_callback_gas,json=maxCallbackGas,proto3" json:"max_callback_gas,omitempty"`
+	// the admin addresses who can update token mapping
+	CronosAdmins         []string `protobuf:"bytes,3,rep,name=cronos_admins,json=cronosAdmins,proto3" json:"cronos_admins,omitempty"`
+	EnableAutoDeployment bool     `protobuf:"varint,4,opt,name=enable_auto_deployment,json=enableAutoDeployment,proto3" json:"enable_auto_deployment,omitempty"`
+	MaxCallbackGas       uint64   `protobuf:"varint,5,opt,name=max_callback_gas,json=maxCallbackGas,proto3" json:"max_callback_gas,omitempty"`
+	// pause the conversions between native tokens and CRC20 tokens requested by users,
+	// the refunds of the in-flight IBC transfers are still processed
+	ConversionPaused bool `protobuf:"varint,6,opt,name=conversion_paused,json=conversionPaused,proto3" json:"conversion_paused,omitempty"`
+	// the maximum amounts of the denoms converted by ConvertVouchers within an epoch, the denoms without a quota
+	// are not limited
+	ConversionQuotas []ConversionQuota `protobuf:"bytes,7,rep,name=conversion_quotas,json=conversionQuotas,proto3" json:"conversion_quotas"`
+	// the number of blocks of the epochs the conversion quotas are reset at
+	QuotaEpochBlocks uint64 `protobuf:"varint,8,opt,name=quota_epoch_blocks,json=quotaEpochBlocks,proto3" json:"quota_epoch_blocks,omitempty"`
+	// the fees charged on the conversions of the denoms to CRC20 tokens, the denoms without a fee are converted
+	// in full
+	ConversionFees []ConversionFee `protobuf:"bytes,9,rep,name=conversion_fees,json=conversionFees,proto3" json:"conversion_fees"`
+	// the address receiving the conversion fees, they're paid to the community pool if empty
+	ConversionFeeCollector string `protobuf:"bytes,10,opt,name=conversion_fee_collector,json=conversionFeeCollector,proto3" json:"conversion_fee_collector,omitempty"`
+	// the denoms contracts are auto-deployed for, the other denoms are only converted to the contracts registered by
+	// the admins, any denom can be auto-deployed if empty
+	AutoDeployAllowlist []string `protobuf:"bytes,11,rep,name=auto_deploy_allowlist,json=autoDeployAllowlist,proto3" json:"auto_deploy_allowlist,omitempty"`
+	// record the recent conversions of the evm addresses, so they can be queried with ConversionHistory
+	EnableConversionHistory bool `protobuf:"varint,12,opt,name=enable_conversion_history,json=enableConversionHistory,proto3" json:"enable_conversion_history,omitempty"`
+	// the number of conversions kept per evm address, the older ones are pruned
+	ConversionHistorySize uint64 `protobuf:"varint,13,opt,name=conversion_history_size,json=conversionHistorySize,proto3" json:"conversion_history_size,omitempty"`
+	// the denoms which can't be converted to CRC20 tokens, in addition to the bond denom
+	ConversionBlocklist []string `protobuf:"bytes,14,rep,name=conversion_blocklist,json=conversionBlocklist,proto3" json:"conversion_blocklist,omitempty"`
+	// the minimum amount converted to CRC20 tokens for the denoms without a minimum of their own, zero disables it
+	MinConversionAmount cosmossdk_io_math.Int `protobuf:"bytes,15,opt,name=min_conversion_amount,json=minConversionAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_conversion_amount"`
+	// the minimum amounts of the denoms converted to CRC20 tokens, they take precedence over min_conversion_amount
+	MinConversionAmounts []ConversionMinimum `protobuf:"bytes,16,rep,name=min_conversion_amounts,json=minConversionAmounts,proto3" json:"min_conversion_amounts"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -79,11 +138,11 @@ func (m *Params) GetIbcTimeout() uint64 {
 	return 0
 }
 
-func (m *Params) GetCronosAdmin() string {
+func (m *Params) GetCronosAdmins() []string {
 	if m != nil {
-		return m.CronosAdmin
+		return m.CronosAdmins
 	}
-	return ""
+	return nil
 }
 
 func (m *Params) GetEnableAutoDeployment() bool {
@@ -100,6 +159,329 @@ func (m *Params) GetMaxCallbackGas() uint64 {
 	return 0
 }
 
+func (m *Params) GetConversionPaused() bool {
+	if m != nil {
+		return m.ConversionPaused
+	}
+	return false
+}
+
+func (m *Params) GetConversionQuotas() []ConversionQuota {
+	if m != nil {
+		return m.ConversionQuotas
+	}
+	return nil
+}
+
+func (m *Params) GetQuotaEpochBlocks() uint64 {
+	if m != nil {
+		return m.QuotaEpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetConversionFees() []ConversionFee {
+	if m != nil {
+		return m.ConversionFees
+	}
+	return nil
+}
+
+func (m *Params) GetConversionFeeCollector() string {
+	if m != nil {
+		return m.ConversionFeeCollector
+	}
+	return ""
+}
+
+func (m *Params) GetAutoDeployAllowlist() []string {
+	if m != nil {
+		return m.AutoDeployAllowlist
+	}
+	return nil
+}
+
+func (m *Params) GetEnableConversionHistory() bool {
+	if m != nil {
+		return m.EnableConversionHistory
+	}
+	return false
+}
+
+func (m *Params) GetConversionHistorySize() uint64 {
+	if m != nil {
+		return m.ConversionHistorySize
+	}
+	return 0
+}
+
+func (m *Params) GetConversionBlocklist() []string {
+	if m != nil {
+		return m.ConversionBlocklist
+	}
+	return nil
+}
+
+func (m *Params) GetMinConversionAmounts() []ConversionMinimum {
+	if m != nil {
+		return m.MinConversionAmounts
+	}
+	return nil
+}
+
+// ConversionQuota defines the maximum amount of a denom converted within an epoch
+type ConversionQuota struct {
+	Denom  string                `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *ConversionQuota) Reset()         { *m = ConversionQuota{} }
+func (m *ConversionQuota) String() string { return proto.CompactTextString(m) }
+func (*ConversionQuota) ProtoMessage()    {}
+func (*ConversionQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{1}
+}
+func (m *ConversionQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionQuota.Merge(m, src)
+}
+func (m *ConversionQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionQuota proto.InternalMessageInfo
+
+func (m *ConversionQuota) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// ConversionMinimum defines the minimum amount of a denom converted to CRC20 tokens
+type ConversionMinimum struct {
+	Denom  string                `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *ConversionMinimum) Reset()         { *m = ConversionMinimum{} }
+func (m *ConversionMinimum) String() string { return proto.CompactTextString(m) }
+func (*ConversionMinimum) ProtoMessage()    {}
+func (*ConversionMinimum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{2}
+}
+func (m *ConversionMinimum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionMinimum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionMinimum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionMinimum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionMinimum.Merge(m, src)
+}
+func (m *ConversionMinimum) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionMinimum) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionMinimum.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionMinimum proto.InternalMessageInfo
+
+func (m *ConversionMinimum) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// ConversionFee defines the fee charged on the conversions of a denom, in basis points of the converted amount
+type ConversionFee struct {
+	Denom       string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	BasisPoints uint32 `protobuf:"varint,2,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+}
+
+func (m *ConversionFee) Reset()         { *m = ConversionFee{} }
+func (m *ConversionFee) String() string { return proto.CompactTextString(m) }
+func (*ConversionFee) ProtoMessage()    {}
+func (*ConversionFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{3}
+}
+func (m *ConversionFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionFee.Merge(m, src)
+}
+func (m *ConversionFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionFee proto.InternalMessageInfo
+
+func (m *ConversionFee) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ConversionFee) GetBasisPoints() uint32 {
+	if m != nil {
+		return m.BasisPoints
+	}
+	return 0
+}
+
+// ConversionRecord defines a conversion recorded in the conversion history of an evm address
+type ConversionRecord struct {
+	Denom  string                `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// the height of the block the conversion is executed in
+	Height    int64               `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Direction ConversionDirection `protobuf:"varint,4,opt,name=direction,proto3,enum=cronos.ConversionDirection" json:"direction,omitempty"`
+}
+
+func (m *ConversionRecord) Reset()         { *m = ConversionRecord{} }
+func (m *ConversionRecord) String() string { return proto.CompactTextString(m) }
+func (*ConversionRecord) ProtoMessage()    {}
+func (*ConversionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{4}
+}
+func (m *ConversionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionRecord.Merge(m, src)
+}
+func (m *ConversionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionRecord proto.InternalMessageInfo
+
+func (m *ConversionRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ConversionRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ConversionRecord) GetDirection() ConversionDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return CONVERSION_DIRECTION_UNSPECIFIED
+}
+
+// ConversionHistory defines the recent conversions of an evm address, the latest first
+type ConversionHistory struct {
+	Records []ConversionRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *ConversionHistory) Reset()         { *m = ConversionHistory{} }
+func (m *ConversionHistory) String() string { return proto.CompactTextString(m) }
+func (*ConversionHistory) ProtoMessage()    {}
+func (*ConversionHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{5}
+}
+func (m *ConversionHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionHistory.Merge(m, src)
+}
+func (m *ConversionHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionHistory proto.InternalMessageInfo
+
+func (m *ConversionHistory) GetRecords() []ConversionRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 // TokenMappingChangeProposal defines a proposal to change one token mapping.
 type TokenMappingChangeProposal struct {
 	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -114,7 +496,7 @@ type TokenMappingChangeProposal struct {
 func (m *TokenMappingChangeProposal) Reset()      { *m = TokenMappingChangeProposal{} }
 func (*TokenMappingChangeProposal) ProtoMessage() {}
 func (*TokenMappingChangeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bc54992a93db2d2, []int{1}
+	return fileDescriptor_8bc54992a93db2d2, []int{6}
 }
 func (m *TokenMappingChangeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -153,7 +535,7 @@ func (m *TokenMapping) Reset()         { *m = TokenMapping{} }
 func (m *TokenMapping) String() string { return proto.CompactTextString(m) }
 func (*TokenMapping) ProtoMessage()    {}
 func (*TokenMapping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bc54992a93db2d2, []int{2}
+	return fileDescriptor_8bc54992a93db2d2, []int{7}
 }
 func (m *TokenMapping) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -197,7 +579,13 @@ func (m *TokenMapping) GetContract() string {
 }
 
 func init() {
+	proto.RegisterEnum("cronos.ConversionDirection", ConversionDirection_name, ConversionDirection_value)
 	proto.RegisterType((*Params)(nil), "cronos.Params")
+	proto.RegisterType((*ConversionQuota)(nil), "cronos.ConversionQuota")
+	proto.RegisterType((*ConversionMinimum)(nil), "cronos.ConversionMinimum")
+	proto.RegisterType((*ConversionFee)(nil), "cronos.ConversionFee")
+	proto.RegisterType((*ConversionRecord)(nil), "cronos.ConversionRecord")
+	proto.RegisterType((*ConversionHistory)(nil), "cronos.ConversionHistory")
 	proto.RegisterType((*TokenMappingChangeProposal)(nil), "cronos.TokenMappingChangeProposal")
 	proto.RegisterType((*TokenMapping)(nil), "cronos.TokenMapping")
 }
@@ -205,36 +593,69 @@ func init() {
 func init() { proto.RegisterFile("cronos/cronos.proto", fileDescriptor_8bc54992a93db2d2) }
 
 var fileDescriptor_8bc54992a93db2d2 = []byte{
-	// 451 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xb1, 0x6f, 0xd3, 0x40,
-	0x14, 0xc6, 0x7d, 0x25, 0x35, 0xc9, 0xa5, 0x45, 0xe8, 0x88, 0x2a, 0x2b, 0x83, 0x63, 0x3c, 0x79,
-	0xa0, 0xb5, 0x04, 0x9d, 0x32, 0xd1, 0xa6, 0x82, 0x09, 0x54, 0x59, 0x9d, 0x58, 0xac, 0xf3, 0xe5,
-	0xe4, 0x9c, 0xea, 0xbb, 0x77, 0x3a, 0x5f, 0x50, 0xfc, 0x1f, 0x30, 0x32, 0x32, 0xf6, 0x6f, 0x61,
-	0x62, 0xec, 0xc8, 0x84, 0x50, 0xf2, 0x1f, 0x30, 0x32, 0x21, 0xfb, 0xd2, 0xd2, 0x0c, 0x9d, 0x7c,
-	0xdf, 0xef, 0x93, 0xf5, 0xbd, 0xef, 0xe9, 0xe1, 0x17, 0xcc, 0x80, 0x82, 0x3a, 0x75, 0x9f, 0x13,
-	0x6d, 0xc0, 0x02, 0xf1, 0x9d, 0x1a, 0x8f, 0x4a, 0x28, 0xa1, 0x43, 0x69, 0xfb, 0x72, 0x6e, 0xfc,
-	0x17, 0x61, 0xff, 0x92, 0x1a, 0x2a, 0x6b, 0xf2, 0x0e, 0x1f, 0x8a, 0x82, 0xe5, 0xcc, 0x40, 0x3e,
-	0xe7, 0x0a, 0x64, 0x80, 0x22, 0x94, 0x0c, 0xce, 0xe3, 0x3f, 0xbf, 0x26, 0x61, 0x43, 0x65, 0x35,
-	0x8d, 0x77, 0xec, 0x57, 0x20, 0x85, 0xe5, 0x52, 0xdb, 0x26, 0xce, 0x86, 0xa2, 0x60, 0x33, 0x03,
-	0x17, 0x2d, 0x27, 0x13, 0xdc, 0xca, 0xdc, 0x0a, 0xc9, 0x61, 0x69, 0x83, 0xbd, 0x08, 0x25, 0xbd,
-	0x0c, 0x8b, 0x82, 0x5d, 0x39, 0x42, 0x5e, 0xe2, 0x03, 0x37, 0x53, 0x4e, 0xe7, 0x52, 0xa8, 0xe0,
-	0x49, 0x9b, 0x93, 0x0d, 0x1d, 0x3b, 0x6b, 0x11, 0x39, 0xc5, 0x47, 0x5c, 0xd1, 0xa2, 0xe2, 0x39,
-	0x5d, 0xda, 0x36, 0x50, 0x57, 0xd0, 0x48, 0xae, 0x6c, 0xd0, 0x8b, 0x50, 0xd2, 0xcf, 0x46, 0xce,
-	0x3d, 0x5b, 0x5a, 0xb8, 0xb8, 0xf7, 0x48, 0x82, 0x9f, 0x4b, 0xba, 0xca, 0x19, 0xad, 0xaa, 0x82,
-	0xb2, 0xeb, 0xbc, 0xa4, 0x75, 0xb0, 0xdf, 0xc5, 0x3f, 0x93, 0x74, 0x35, 0xdb, 0xe2, 0xf7, 0xb4,
-	0x9e, 0xf6, 0xbe, 0xdd, 0x4c, 0xbc, 0xf8, 0x3b, 0xc2, 0xe3, 0x2b, 0xb8, 0xe6, 0xea, 0x03, 0xd5,
-	0x5a, 0xa8, 0x72, 0xb6, 0xa0, 0xaa, 0xe4, 0x97, 0x06, 0x34, 0xd4, 0xb4, 0x22, 0x23, 0xbc, 0x6f,
-	0x85, 0xad, 0xb8, 0x5b, 0x44, 0xe6, 0x04, 0x89, 0xf0, 0x70, 0xce, 0x6b, 0x66, 0x84, 0xb6, 0x02,
-	0x54, 0x57, 0x6f, 0x90, 0x3d, 0x44, 0xed, 0x7f, 0x6e, 0x81, 0xae, 0x98, 0x13, 0x64, 0x8c, 0xfb,
-	0x0c, 0x94, 0x35, 0x94, 0xb9, 0x12, 0x83, 0xec, 0x5e, 0x93, 0x23, 0xec, 0xd7, 0x8d, 0x2c, 0xa0,
-	0xea, 0xc6, 0x1d, 0x64, 0x5b, 0x45, 0x02, 0xfc, 0x74, 0xce, 0x99, 0x90, 0xb4, 0x0a, 0xfc, 0x08,
-	0x25, 0x87, 0xd9, 0x9d, 0x9c, 0xf6, 0xbf, 0xdc, 0x4c, 0xbc, 0xae, 0xc4, 0x5b, 0x7c, 0xf0, 0xb0,
-	0xc3, 0xff, 0x74, 0xf4, 0x58, 0xfa, 0xde, 0x6e, 0xfa, 0xf9, 0xc7, 0x1f, 0xeb, 0x10, 0xdd, 0xae,
-	0x43, 0xf4, 0x7b, 0x1d, 0xa2, 0xaf, 0x9b, 0xd0, 0xbb, 0xdd, 0x84, 0xde, 0xcf, 0x4d, 0xe8, 0x7d,
-	0x3a, 0x2d, 0x85, 0x5d, 0x2c, 0x8b, 0x13, 0x06, 0x32, 0x65, 0xa6, 0xd1, 0x16, 0x8e, 0xc1, 0x94,
-	0xc7, 0x6c, 0x41, 0x85, 0xda, 0x5e, 0x59, 0xfa, 0xf9, 0x75, 0xba, 0xba, 0x7b, 0xdb, 0x46, 0xf3,
-	0xba, 0xf0, 0xbb, 0xd3, 0x7a, 0xf3, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xad, 0x72, 0x13, 0x5e, 0x8f,
-	0x02, 0x00, 0x00,
+	// 981 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0xc6, 0xa9, 0x13, 0x4f, 0xe2, 0xd4, 0x9d, 0x7c, 0x4d, 0x82, 0xb0, 0xdd, 0x85, 0x83,
+	0x45, 0x9b, 0x18, 0x42, 0x85, 0x4a, 0x4e, 0x24, 0x6b, 0x87, 0x1a, 0x29, 0x1f, 0x6c, 0xdc, 0x1e,
+	0xb8, 0xac, 0x66, 0xc7, 0x83, 0x3d, 0xca, 0xce, 0xcc, 0xb2, 0x33, 0x2e, 0x71, 0x7f, 0x41, 0x8e,
+	0x1c, 0x39, 0x56, 0xe2, 0x2f, 0x20, 0xce, 0x88, 0x53, 0x8f, 0x15, 0x27, 0xc4, 0x21, 0x42, 0xc9,
+	0x3f, 0xe0, 0x17, 0xa0, 0x9d, 0x5d, 0xc7, 0xeb, 0x3a, 0x39, 0x70, 0xe8, 0x29, 0xfb, 0x3e, 0xcf,
+	0x3b, 0xef, 0xc7, 0xf3, 0xbe, 0x33, 0x31, 0x58, 0x26, 0x91, 0x14, 0x52, 0x35, 0x92, 0x3f, 0xdb,
+	0x61, 0x24, 0xb5, 0x84, 0x85, 0xc4, 0xda, 0x5c, 0xe9, 0xc9, 0x9e, 0x34, 0x50, 0x23, 0xfe, 0x4a,
+	0xd8, 0xcd, 0x0d, 0x22, 0x15, 0x97, 0xca, 0x4b, 0x88, 0xc4, 0x48, 0x28, 0xfb, 0xb7, 0x39, 0x50,
+	0x38, 0xc1, 0x11, 0xe6, 0x0a, 0x1e, 0x80, 0x12, 0xf3, 0x89, 0x47, 0x22, 0xe9, 0x75, 0xa9, 0x90,
+	0x1c, 0x59, 0x35, 0xab, 0x5e, 0xdc, 0xb7, 0xff, 0xbd, 0xac, 0x56, 0x86, 0x98, 0x07, 0xbb, 0xf6,
+	0x04, 0xfd, 0x58, 0x72, 0xa6, 0x29, 0x0f, 0xf5, 0xd0, 0x76, 0x17, 0x98, 0x4f, 0x9c, 0x48, 0x36,
+	0x63, 0x1c, 0x56, 0x41, 0x6c, 0x7a, 0x9a, 0x71, 0x2a, 0x07, 0x1a, 0xcd, 0xd4, 0xac, 0xfa, 0xac,
+	0x0b, 0x98, 0x4f, 0x3a, 0x09, 0x02, 0x3f, 0x02, 0xa5, 0xa4, 0x5c, 0x0f, 0x77, 0x39, 0x13, 0x0a,
+	0xe5, 0x6b, 0xf9, 0x7a, 0xd1, 0x5d, 0x4c, 0xc0, 0x3d, 0x83, 0xc1, 0x27, 0x60, 0x8d, 0x0a, 0xec,
+	0x07, 0xd4, 0xc3, 0x03, 0x1d, 0xa7, 0x0c, 0x03, 0x39, 0xe4, 0x54, 0x68, 0x34, 0x5b, 0xb3, 0xea,
+	0xf3, 0xee, 0x4a, 0xc2, 0xee, 0x0d, 0xb4, 0x6c, 0xde, 0x70, 0xb0, 0x0e, 0xca, 0x1c, 0x9f, 0x7b,
+	0x04, 0x07, 0x81, 0x8f, 0xc9, 0x99, 0xd7, 0xc3, 0x0a, 0xdd, 0x33, 0x05, 0x2c, 0x71, 0x7c, 0xee,
+	0xa4, 0xf0, 0xd7, 0x58, 0xc1, 0x47, 0xe0, 0x01, 0x91, 0xe2, 0x25, 0x8d, 0x14, 0x93, 0xc2, 0x0b,
+	0xf1, 0x40, 0xd1, 0x2e, 0x2a, 0x98, 0xd0, 0xe5, 0x31, 0x71, 0x62, 0x70, 0xf8, 0xcd, 0x84, 0xf3,
+	0x0f, 0x03, 0xa9, 0xb1, 0x42, 0x73, 0xb5, 0x7c, 0x7d, 0x61, 0x67, 0x7d, 0x3b, 0x1d, 0x84, 0x73,
+	0xe3, 0xf0, 0x6d, 0xcc, 0xef, 0xcf, 0xbe, 0xb9, 0xac, 0xe6, 0xb2, 0xb1, 0x0c, 0xac, 0xe0, 0x63,
+	0x00, 0x4d, 0x00, 0x8f, 0x86, 0x92, 0xf4, 0x3d, 0x3f, 0x90, 0xe4, 0x4c, 0xa1, 0x79, 0x53, 0x64,
+	0xd9, 0x30, 0xad, 0x98, 0xd8, 0x37, 0x38, 0x6c, 0x82, 0xfb, 0x99, 0xcc, 0xdf, 0x53, 0xaa, 0x50,
+	0xd1, 0xe4, 0x5d, 0x9d, 0xce, 0x7b, 0x40, 0x69, 0x9a, 0x75, 0x89, 0x64, 0x41, 0x05, 0x9f, 0x02,
+	0x34, 0x19, 0xc5, 0x23, 0x32, 0x08, 0x28, 0xd1, 0x32, 0x42, 0x20, 0x9e, 0xb2, 0xbb, 0x36, 0x71,
+	0xc2, 0x19, 0xb1, 0x70, 0x07, 0xac, 0x66, 0xf4, 0xf7, 0x70, 0x10, 0xc8, 0x1f, 0x03, 0xa6, 0x34,
+	0x5a, 0x30, 0x33, 0x5b, 0xc6, 0x37, 0xfa, 0xef, 0x8d, 0x28, 0xb8, 0x0b, 0x36, 0xd2, 0xd1, 0x65,
+	0x92, 0xf6, 0x99, 0xd2, 0x32, 0x1a, 0xa2, 0x45, 0x23, 0xf1, 0x7a, 0xe2, 0x30, 0xae, 0xfd, 0x59,
+	0x42, 0xc3, 0x2f, 0xc0, 0xfa, 0xf4, 0x21, 0x4f, 0xb1, 0x57, 0x14, 0x95, 0x8c, 0x44, 0xab, 0xe4,
+	0xdd, 0x33, 0xa7, 0xec, 0x15, 0x85, 0x9f, 0x81, 0x95, 0xcc, 0x39, 0x23, 0xaa, 0x29, 0x73, 0x29,
+	0x29, 0x73, 0xcc, 0xed, 0x8f, 0x28, 0xe8, 0x81, 0x55, 0xce, 0x44, 0xb6, 0x46, 0xcc, 0xe5, 0x40,
+	0x68, 0x74, 0xdf, 0xec, 0xfd, 0xa3, 0x58, 0xc9, 0xbf, 0x2f, 0xab, 0xab, 0xc9, 0x7d, 0x51, 0xdd,
+	0xb3, 0x6d, 0x26, 0x1b, 0x1c, 0xeb, 0xfe, 0x76, 0x5b, 0xe8, 0x3f, 0x7f, 0xdd, 0x02, 0xe9, 0x45,
+	0x6a, 0x0b, 0xed, 0x2e, 0x73, 0x26, 0xc6, 0xcd, 0xec, 0x99, 0x38, 0xf0, 0x39, 0x58, 0xbb, 0x35,
+	0x81, 0x42, 0x65, 0x33, 0xc2, 0x8d, 0xe9, 0x11, 0x1e, 0x32, 0xc1, 0xf8, 0x80, 0xa7, 0x63, 0x5c,
+	0xb9, 0x25, 0xaa, 0xda, 0x9d, 0xfd, 0xf9, 0x75, 0x35, 0x67, 0x07, 0xe0, 0xfe, 0x3b, 0x1b, 0x07,
+	0x57, 0xc0, 0xbd, 0xcc, 0xc5, 0x75, 0x13, 0x03, 0x3a, 0xa0, 0x90, 0xf6, 0x35, 0xf3, 0xff, 0xfb,
+	0x4a, 0x8f, 0xda, 0x02, 0x3c, 0x98, 0x2a, 0xf2, 0x7d, 0xe6, 0x7b, 0x06, 0x4a, 0x13, 0x7b, 0x7d,
+	0x47, 0xae, 0x87, 0x60, 0xd1, 0xc7, 0x8a, 0x29, 0x2f, 0x94, 0x2c, 0xd6, 0x35, 0xce, 0x58, 0x72,
+	0x17, 0x0c, 0x76, 0x62, 0x20, 0xfb, 0x77, 0x0b, 0x94, 0xc7, 0xa1, 0x5c, 0x4a, 0x64, 0xd4, 0x7d,
+	0x8f, 0x95, 0xc3, 0x35, 0x50, 0xe8, 0x53, 0xd6, 0xeb, 0x6b, 0x94, 0xaf, 0x59, 0xf5, 0xbc, 0x9b,
+	0x5a, 0xf0, 0x4b, 0x50, 0xec, 0xb2, 0x88, 0x12, 0xcd, 0xa4, 0x30, 0x4f, 0xd8, 0xd2, 0xce, 0x07,
+	0xd3, 0xf3, 0x6f, 0x8e, 0x5c, 0xdc, 0xb1, 0xb7, 0x7d, 0x98, 0x15, 0x7f, 0x74, 0x51, 0x9e, 0x82,
+	0xb9, 0xc8, 0x34, 0xa3, 0x90, 0x65, 0xb6, 0x09, 0x4d, 0x47, 0x4b, 0xba, 0x4d, 0x97, 0x69, 0xe4,
+	0x6e, 0xff, 0x61, 0x81, 0xcd, 0x8e, 0x3c, 0xa3, 0xe2, 0x10, 0x87, 0x21, 0x13, 0x3d, 0xa7, 0x8f,
+	0x45, 0x8f, 0x9e, 0x44, 0x32, 0x94, 0x0a, 0x07, 0xb1, 0x36, 0x9a, 0xe9, 0x80, 0x8e, 0xb4, 0x31,
+	0x06, 0xac, 0x81, 0x85, 0x2e, 0x55, 0x24, 0x62, 0xa1, 0x69, 0xc0, 0x08, 0xe4, 0x66, 0xa1, 0xb1,
+	0xa6, 0xf9, 0xac, 0xa6, 0x9b, 0x60, 0x9e, 0x48, 0xa1, 0x23, 0x4c, 0x92, 0x87, 0xbb, 0xe8, 0xde,
+	0xd8, 0xb1, 0x54, 0x6a, 0xc8, 0x7d, 0x19, 0x98, 0x27, 0xba, 0xe8, 0xa6, 0x16, 0x44, 0x60, 0xae,
+	0x4b, 0x09, 0xe3, 0x38, 0x30, 0x0f, 0x72, 0xc9, 0x1d, 0x99, 0xbb, 0xf3, 0x17, 0xaf, 0xab, 0x39,
+	0xb3, 0xfe, 0x5f, 0x81, 0xc5, 0x6c, 0x0f, 0x77, 0x4c, 0x34, 0x9b, 0x7d, 0x66, 0x32, 0xfb, 0x27,
+	0x17, 0x16, 0x58, 0xbe, 0x45, 0x78, 0xf8, 0x31, 0xa8, 0x39, 0xc7, 0x47, 0x2f, 0x5a, 0xee, 0x69,
+	0xfb, 0xf8, 0xc8, 0x6b, 0xb6, 0xdd, 0x96, 0xd3, 0x89, 0xbf, 0x9e, 0x1f, 0x9d, 0x9e, 0xb4, 0x9c,
+	0xf6, 0x41, 0xbb, 0xd5, 0x2c, 0xe7, 0xe0, 0x43, 0xf0, 0xe1, 0xad, 0x5e, 0x9d, 0x63, 0xcf, 0x71,
+	0x9d, 0x9d, 0x4f, 0xcb, 0x16, 0xb4, 0x41, 0xe5, 0x2e, 0x97, 0xa3, 0xbd, 0x4e, 0xfb, 0x45, 0xab,
+	0x3c, 0xb3, 0x39, 0x7b, 0xf1, 0x4b, 0x25, 0xb7, 0x7f, 0xf4, 0xe6, 0xaa, 0x62, 0xbd, 0xbd, 0xaa,
+	0x58, 0xff, 0x5c, 0x55, 0xac, 0x9f, 0xae, 0x2b, 0xb9, 0xb7, 0xd7, 0x95, 0xdc, 0x5f, 0xd7, 0x95,
+	0xdc, 0x77, 0x4f, 0x7a, 0x4c, 0xf7, 0x07, 0xfe, 0x36, 0x91, 0xbc, 0x41, 0xa2, 0x61, 0xa8, 0xe5,
+	0x96, 0x8c, 0x7a, 0x5b, 0xa4, 0x8f, 0x99, 0x48, 0x7f, 0x01, 0x34, 0x5e, 0xee, 0x34, 0xce, 0x47,
+	0xdf, 0x7a, 0x18, 0x52, 0xe5, 0x17, 0xcc, 0xff, 0xf6, 0xcf, 0xff, 0x1b, 0x00, 0xca, 0x20, 0x13,
+	0xa4, 0x2b, 0x08, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -257,41 +678,354 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.MaxCallbackGas != 0 {
-		i = encodeVarintCronos(dAtA, i, uint64(m.MaxCallbackGas))
+	if len(m.MinConversionAmounts) > 0 {
+		for iNdEx := len(m.MinConversionAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinConversionAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCronos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	{
+		size := m.MinConversionAmount.Size()
+		i -= size
+		if _, err := m.MinConversionAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCronos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.ConversionBlocklist) > 0 {
+		for iNdEx := len(m.ConversionBlocklist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConversionBlocklist[iNdEx])
+			copy(dAtA[i:], m.ConversionBlocklist[iNdEx])
+			i = encodeVarintCronos(dAtA, i, uint64(len(m.ConversionBlocklist[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.ConversionHistorySize != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.ConversionHistorySize))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x68
 	}
-	if m.EnableAutoDeployment {
+	if m.EnableConversionHistory {
 		i--
-		if m.EnableAutoDeployment {
+		if m.EnableConversionHistory {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x60
+	}
+	if len(m.AutoDeployAllowlist) > 0 {
+		for iNdEx := len(m.AutoDeployAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoDeployAllowlist[iNdEx])
+			copy(dAtA[i:], m.AutoDeployAllowlist[iNdEx])
+			i = encodeVarintCronos(dAtA, i, uint64(len(m.AutoDeployAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
-	if len(m.CronosAdmin) > 0 {
-		i -= len(m.CronosAdmin)
-		copy(dAtA[i:], m.CronosAdmin)
-		i = encodeVarintCronos(dAtA, i, uint64(len(m.CronosAdmin)))
+	if len(m.ConversionFeeCollector) > 0 {
+		i -= len(m.ConversionFeeCollector)
+		copy(dAtA[i:], m.ConversionFeeCollector)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.ConversionFeeCollector)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x52
 	}
-	if m.IbcTimeout != 0 {
-		i = encodeVarintCronos(dAtA, i, uint64(m.IbcTimeout))
+	if len(m.ConversionFees) > 0 {
+		for iNdEx := len(m.ConversionFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConversionFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCronos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.QuotaEpochBlocks != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.QuotaEpochBlocks))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x40
 	}
-	if len(m.IbcCroDenom) > 0 {
-		i -= len(m.IbcCroDenom)
-		copy(dAtA[i:], m.IbcCroDenom)
-		i = encodeVarintCronos(dAtA, i, uint64(len(m.IbcCroDenom)))
+	if len(m.ConversionQuotas) > 0 {
+		for iNdEx := len(m.ConversionQuotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConversionQuotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCronos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ConversionPaused {
 		i--
-		dAtA[i] = 0xa
+		if m.ConversionPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	return len(dAtA) - i, nil
+	if m.MaxCallbackGas != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.MaxCallbackGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EnableAutoDeployment {
+		i--
+		if m.EnableAutoDeployment {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CronosAdmins) > 0 {
+		for iNdEx := len(m.CronosAdmins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CronosAdmins[iNdEx])
+			copy(dAtA[i:], m.CronosAdmins[iNdEx])
+			i = encodeVarintCronos(dAtA, i, uint64(len(m.CronosAdmins[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.IbcTimeout != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.IbcTimeout))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.IbcCroDenom) > 0 {
+		i -= len(m.IbcCroDenom)
+		copy(dAtA[i:], m.IbcCroDenom)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.IbcCroDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConversionQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCronos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConversionMinimum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionMinimum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionMinimum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCronos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConversionFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BasisPoints != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConversionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Direction != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCronos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConversionHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCronos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *TokenMappingChangeProposal) Marshal() (dAtA []byte, err error) {
@@ -418,9 +1152,11 @@ func (m *Params) Size() (n int) {
 	if m.IbcTimeout != 0 {
 		n += 1 + sovCronos(uint64(m.IbcTimeout))
 	}
-	l = len(m.CronosAdmin)
-	if l > 0 {
-		n += 1 + l + sovCronos(uint64(l))
+	if len(m.CronosAdmins) > 0 {
+		for _, s := range m.CronosAdmins {
+			l = len(s)
+			n += 1 + l + sovCronos(uint64(l))
+		}
 	}
 	if m.EnableAutoDeployment {
 		n += 2
@@ -428,47 +1164,153 @@ func (m *Params) Size() (n int) {
 	if m.MaxCallbackGas != 0 {
 		n += 1 + sovCronos(uint64(m.MaxCallbackGas))
 	}
+	if m.ConversionPaused {
+		n += 2
+	}
+	if len(m.ConversionQuotas) > 0 {
+		for _, e := range m.ConversionQuotas {
+			l = e.Size()
+			n += 1 + l + sovCronos(uint64(l))
+		}
+	}
+	if m.QuotaEpochBlocks != 0 {
+		n += 1 + sovCronos(uint64(m.QuotaEpochBlocks))
+	}
+	if len(m.ConversionFees) > 0 {
+		for _, e := range m.ConversionFees {
+			l = e.Size()
+			n += 1 + l + sovCronos(uint64(l))
+		}
+	}
+	l = len(m.ConversionFeeCollector)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	if len(m.AutoDeployAllowlist) > 0 {
+		for _, s := range m.AutoDeployAllowlist {
+			l = len(s)
+			n += 1 + l + sovCronos(uint64(l))
+		}
+	}
+	if m.EnableConversionHistory {
+		n += 2
+	}
+	if m.ConversionHistorySize != 0 {
+		n += 1 + sovCronos(uint64(m.ConversionHistorySize))
+	}
+	if len(m.ConversionBlocklist) > 0 {
+		for _, s := range m.ConversionBlocklist {
+			l = len(s)
+			n += 1 + l + sovCronos(uint64(l))
+		}
+	}
+	l = m.MinConversionAmount.Size()
+	n += 1 + l + sovCronos(uint64(l))
+	if len(m.MinConversionAmounts) > 0 {
+		for _, e := range m.MinConversionAmounts {
+			l = e.Size()
+			n += 2 + l + sovCronos(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *TokenMappingChangeProposal) Size() (n int) {
+func (m *ConversionQuota) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Title)
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovCronos(uint64(l))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovCronos(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovCronos(uint64(l))
+	return n
+}
+
+func (m *ConversionMinimum) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
 	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovCronos(uint64(l))
 	}
-	l = len(m.Contract)
+	l = m.Amount.Size()
+	n += 1 + l + sovCronos(uint64(l))
+	return n
+}
+
+func (m *ConversionFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovCronos(uint64(l))
 	}
-	l = len(m.Symbol)
+	if m.BasisPoints != 0 {
+		n += 1 + sovCronos(uint64(m.BasisPoints))
+	}
+	return n
+}
+
+func (m *ConversionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovCronos(uint64(l))
 	}
-	if m.Decimal != 0 {
-		n += 1 + sovCronos(uint64(m.Decimal))
+	l = m.Amount.Size()
+	n += 1 + l + sovCronos(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovCronos(uint64(m.Height))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovCronos(uint64(m.Direction))
 	}
 	return n
 }
 
-func (m *TokenMapping) Size() (n int) {
+func (m *ConversionHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovCronos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenMappingChangeProposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
 	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovCronos(uint64(l))
@@ -477,7 +1319,31 @@ func (m *TokenMapping) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCronos(uint64(l))
 	}
-	return n
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	if m.Decimal != 0 {
+		n += 1 + sovCronos(uint64(m.Decimal))
+	}
+	return n
+}
+
+func (m *TokenMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	return n
 }
 
 func sovCronos(x uint64) (n int) {
@@ -568,7 +1434,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CronosAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CronosAdmins", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -596,7 +1462,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CronosAdmin = string(dAtA[iNdEx:postIndex])
+			m.CronosAdmins = append(m.CronosAdmins, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
@@ -637,6 +1503,887 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConversionPaused = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionQuotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionQuotas = append(m.ConversionQuotas, ConversionQuota{})
+			if err := m.ConversionQuotas[len(m.ConversionQuotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaEpochBlocks", wireType)
+			}
+			m.QuotaEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuotaEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionFees = append(m.ConversionFees, ConversionFee{})
+			if err := m.ConversionFees[len(m.ConversionFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFeeCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionFeeCollector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoDeployAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoDeployAllowlist = append(m.AutoDeployAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableConversionHistory", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableConversionHistory = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionHistorySize", wireType)
+			}
+			m.ConversionHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConversionHistorySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionBlocklist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionBlocklist = append(m.ConversionBlocklist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinConversionAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinConversionAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinConversionAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinConversionAmounts = append(m.MinConversionAmounts, ConversionMinimum{})
+			if err := m.MinConversionAmounts[len(m.MinConversionAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionMinimum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionMinimum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionMinimum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= ConversionDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, ConversionRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCronos(dAtA[iNdEx:])
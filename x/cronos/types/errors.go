@@ -9,11 +9,39 @@ import (
 const (
 	codeErrIbcCroDenomEmpty = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
 	codeErrIbcCroDenomInvalid
+	codeErrCronosAdminEmpty
+	codeErrDenomTraceNotFound
+	codeErrConversionPaused
+	codeErrContractCodeNotFound
+	codeErrAmountNotDivisible
+	codeErrSupplyNotEmpty
+	codeErrAmountOverflow
+	codeErrQuotaExceeded
+	codeErrDenomNotAllowed
+	codeErrDenomNotConvertible
+	codeErrChannelNotFound
+	codeErrChannelNotOpen
+	codeErrContractCodeExists
+	codeErrAmountTooSmall
 )
 
 // x/cronos module sentinel errors
 var (
-	ErrIbcCroDenomEmpty   = errors.Register(ModuleName, codeErrIbcCroDenomEmpty, "ibc cro denom is not set")
-	ErrIbcCroDenomInvalid = errors.Register(ModuleName, codeErrIbcCroDenomInvalid, "ibc cro denom is invalid")
+	ErrIbcCroDenomEmpty     = errors.Register(ModuleName, codeErrIbcCroDenomEmpty, "ibc cro denom is not set")
+	ErrIbcCroDenomInvalid   = errors.Register(ModuleName, codeErrIbcCroDenomInvalid, "ibc cro denom is invalid")
+	ErrCronosAdminEmpty     = errors.Register(ModuleName, codeErrCronosAdminEmpty, "cronos admin is not set")
+	ErrDenomTraceNotFound   = errors.Register(ModuleName, codeErrDenomTraceNotFound, "denom trace not found")
+	ErrConversionPaused     = errors.Register(ModuleName, codeErrConversionPaused, "conversions are paused")
+	ErrContractCodeNotFound = errors.Register(ModuleName, codeErrContractCodeNotFound, "contract code not found")
+	ErrAmountNotDivisible   = errors.Register(ModuleName, codeErrAmountNotDivisible, "amount is not divisible by the scaling factor")
+	ErrSupplyNotEmpty       = errors.Register(ModuleName, codeErrSupplyNotEmpty, "contract total supply is not zero")
+	ErrAmountOverflow       = errors.Register(ModuleName, codeErrAmountOverflow, "amount overflows the uint256 crc20 balance")
+	ErrQuotaExceeded        = errors.Register(ModuleName, codeErrQuotaExceeded, "conversion quota exceeded")
+	ErrDenomNotAllowed      = errors.Register(ModuleName, codeErrDenomNotAllowed, "denom is not allowed to auto-deploy a contract")
+	ErrDenomNotConvertible  = errors.Register(ModuleName, codeErrDenomNotConvertible, "denom is not convertible")
+	ErrChannelNotFound      = errors.Register(ModuleName, codeErrChannelNotFound, "ibc channel not found")
+	ErrChannelNotOpen       = errors.Register(ModuleName, codeErrChannelNotOpen, "ibc channel is not open")
+	ErrContractCodeExists   = errors.Register(ModuleName, codeErrContractCodeExists, "contract code exists")
+	ErrAmountTooSmall       = errors.Register(ModuleName, codeErrAmountTooSmall, "amount is below the minimum conversion amount")
 	// this line is used by starport scaffolding # ibc/errors
 )
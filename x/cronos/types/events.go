@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -14,23 +16,48 @@ const (
 	AttributeKeyAmount                = "amount"
 	AttributeKeyReceiver              = "receiver"
 	AttributeKeyEthereumTokenContract = "ethereum_token_contract"
+	AttributeKeyDenom                 = "denom"
+	AttributeKeyPacketSequence        = "packet_sequence"
+	AttributeKeyPacketChannel         = "packet_channel"
+	AttributeKeyOldAdmin              = "old_admin"
+	AttributeKeyNewAdmin              = "new_admin"
+	AttributeKeyReason                = "reason"
+	AttributeKeyPaused                = "paused"
 
-	// events
+	// events, the convert_vouchers, convert_voucher, convert_coin and transfer_tokens ones are superseded
+	// by the typed events of events.proto and are only kept for compatibility until the next release
 	EventTypeConvertVouchers             = "convert_vouchers"
+	EventTypeConvertVoucher              = "convert_voucher"
 	EventTypeTransferTokens              = "transfer_tokens"
+	EventTypeRefundVouchers              = "refund_vouchers"
+	EventTypeUpdateAdmin                 = "update_admin"
+	EventTypeAutoConvertFallback         = "auto_convert_fallback"
+	EventTypeConvertCoin                 = "convert_coin"
 	EventTypeEthereumSendToCosmosHandled = "ethereum_send_to_cosmos_handled"
+	EventTypeConversionPause             = "conversion_pause"
 )
 
 // NewConvertVouchersEvent constructs a new voucher convert sdk.Event
 // nolint: interfacer
-func NewConvertVouchersEvent(sender string, amount fmt.Stringer) sdk.Event {
+func NewConvertVouchersEvent(sender string, recipient string, amount fmt.Stringer) sdk.Event {
 	return sdk.NewEvent(
 		EventTypeConvertVouchers,
 		sdk.NewAttribute(AttributeKeySender, sender),
+		sdk.NewAttribute(AttributeKeyRecipient, recipient),
 		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
 	)
 }
 
+// NewConvertVoucherEvent constructs a new sdk.Event for a single converted denom
+func NewConvertVoucherEvent(sender string, coin sdk.Coin) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeConvertVoucher,
+		sdk.NewAttribute(AttributeKeySender, sender),
+		sdk.NewAttribute(AttributeKeyDenom, coin.Denom),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, coin.Amount.String()),
+	)
+}
+
 // NewTransferTokensEvent constructs a new transfer sdk.Event
 func NewTransferTokensEvent(sender string, recipient string, amount fmt.Stringer) sdk.Event {
 	return sdk.NewEvent(
@@ -40,3 +67,53 @@ func NewTransferTokensEvent(sender string, recipient string, amount fmt.Stringer
 		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
 	)
 }
+
+// NewRefundVouchersEvent constructs a new sdk.Event for the vouchers refunded as evm tokens
+func NewRefundVouchersEvent(sender string, channel string, sequence uint64, amount fmt.Stringer) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeRefundVouchers,
+		sdk.NewAttribute(AttributeKeySender, sender),
+		sdk.NewAttribute(AttributeKeyPacketChannel, channel),
+		sdk.NewAttribute(AttributeKeyPacketSequence, strconv.FormatUint(sequence, 10)),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	)
+}
+
+// NewUpdateAdminEvent constructs a new sdk.Event for the rotation of the cronos admins
+func NewUpdateAdminEvent(oldAdmins []string, newAdmins []string) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeUpdateAdmin,
+		sdk.NewAttribute(AttributeKeyOldAdmin, strings.Join(oldAdmins, ",")),
+		sdk.NewAttribute(AttributeKeyNewAdmin, strings.Join(newAdmins, ",")),
+	)
+}
+
+// NewAutoConvertFallbackEvent constructs a new sdk.Event for the received vouchers kept as is
+// because the conversion requested in the memo failed
+func NewAutoConvertFallbackEvent(receiver string, amount fmt.Stringer, reason string) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeAutoConvertFallback,
+		sdk.NewAttribute(AttributeKeyReceiver, receiver),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(AttributeKeyReason, reason),
+	)
+}
+
+// NewConvertCoinEvent constructs a new sdk.Event for a native coin converted to crc20 tokens
+func NewConvertCoinEvent(sender string, contract string, coin sdk.Coin) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeConvertCoin,
+		sdk.NewAttribute(AttributeKeySender, sender),
+		sdk.NewAttribute(AttributeKeyEthereumTokenContract, contract),
+		sdk.NewAttribute(AttributeKeyDenom, coin.Denom),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, coin.Amount.String()),
+	)
+}
+
+// NewConversionPauseEvent constructs a new sdk.Event for a change of the conversion pause state
+func NewConversionPauseEvent(paused bool) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeConversionPause,
+		sdk.NewAttribute(AttributeKeyPaused, strconv.FormatBool(paused)),
+	)
+}
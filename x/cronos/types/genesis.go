@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
 // this line is used by starport scaffolding # genesis/types/import
 // this line is used by starport scaffolding # ibc/genesistype/import
 
@@ -22,5 +28,38 @@ func (gs GenesisState) Validate() error {
 
 	// this line is used by starport scaffolding # genesis/types/validate
 
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// a contract can only be connected to one denom
+	contracts := make(map[common.Address]string)
+	for _, mappings := range [][]TokenMapping{gs.ExternalContracts, gs.AutoContracts} {
+		denoms := make(map[string]struct{}, len(mappings))
+		for _, m := range mappings {
+			if !IsValidCoinDenom(m.Denom) {
+				return fmt.Errorf("invalid denom to map to contract: %s", m.Denom)
+			}
+			if !common.IsHexAddress(m.Contract) {
+				return fmt.Errorf("invalid contract address: %s", m.Contract)
+			}
+			if _, ok := denoms[m.Denom]; ok {
+				return fmt.Errorf("duplicated token mapping for denom: %s", m.Denom)
+			}
+			denoms[m.Denom] = struct{}{}
+
+			contract := common.HexToAddress(m.Contract)
+			if denom, ok := contracts[contract]; ok && denom != m.Denom {
+				return fmt.Errorf("contract %s is mapped to both %s and %s", m.Contract, denom, m.Denom)
+			}
+			contracts[contract] = m.Denom
+		}
+	}
+	for _, m := range gs.AutoContracts {
+		if IsSourceCoin(m.Denom) {
+			return fmt.Errorf("cronos originated token can't be mapped to auto-deployed contract: %s", m.Denom)
+		}
+	}
+
+	return nil
 }
@@ -28,6 +28,75 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid token mappings",
+			GenesisState{
+				Params: DefaultParams(),
+				ExternalContracts: []TokenMapping{
+					{Denom: IbcCroDenomDefaultValue, Contract: "0x0000000000000000000000000000000000000001"},
+					{Denom: "cronos0x0000000000000000000000000000000000000002", Contract: "0x0000000000000000000000000000000000000002"},
+				},
+				AutoContracts: []TokenMapping{
+					{Denom: IbcCroDenomDefaultValue, Contract: "0x0000000000000000000000000000000000000003"},
+					{Denom: "gravity0x0000000000000000000000000000000000000004", Contract: "0x0000000000000000000000000000000000000004"},
+				},
+			},
+			false,
+		},
+		{
+			"duplicated denom",
+			GenesisState{
+				Params: DefaultParams(),
+				ExternalContracts: []TokenMapping{
+					{Denom: IbcCroDenomDefaultValue, Contract: "0x0000000000000000000000000000000000000001"},
+					{Denom: IbcCroDenomDefaultValue, Contract: "0x0000000000000000000000000000000000000002"},
+				},
+			},
+			true,
+		},
+		{
+			"contract mapped to two denoms",
+			GenesisState{
+				Params: DefaultParams(),
+				ExternalContracts: []TokenMapping{
+					{Denom: IbcCroDenomDefaultValue, Contract: "0x0000000000000000000000000000000000000001"},
+				},
+				AutoContracts: []TokenMapping{
+					{Denom: "gravity0x0000000000000000000000000000000000000004", Contract: "0x0000000000000000000000000000000000000001"},
+				},
+			},
+			true,
+		},
+		{
+			"malformed contract",
+			GenesisState{
+				Params: DefaultParams(),
+				AutoContracts: []TokenMapping{
+					{Denom: IbcCroDenomDefaultValue, Contract: "0x01"},
+				},
+			},
+			true,
+		},
+		{
+			"invalid denom",
+			GenesisState{
+				Params: DefaultParams(),
+				ExternalContracts: []TokenMapping{
+					{Denom: "stake", Contract: "0x0000000000000000000000000000000000000001"},
+				},
+			},
+			true,
+		},
+		{
+			"source token mapped to auto-deployed contract",
+			GenesisState{
+				Params: DefaultParams(),
+				AutoContracts: []TokenMapping{
+					{Denom: "cronos0x0000000000000000000000000000000000000002", Contract: "0x0000000000000000000000000000000000000002"},
+				},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var _ CronosHooks = MultiCronosHooks{}
+
+// MultiCronosHooks combines multiple cronos hooks, all hook functions are run in array sequence
+type MultiCronosHooks []CronosHooks
+
+// NewMultiCronosHooks creates a new MultiCronosHooks
+func NewMultiCronosHooks(hooks ...CronosHooks) MultiCronosHooks {
+	return hooks
+}
+
+// AfterConvertVouchers runs the AfterConvertVouchers hooks in sequence, stops at the first error
+func (h MultiCronosHooks) AfterConvertVouchers(ctx sdk.Context, sender sdk.AccAddress, recipient common.Address, coins sdk.Coins) error {
+	for i := range h {
+		if err := h[i].AfterConvertVouchers(ctx, sender, recipient, coins); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterConvertCoin runs the AfterConvertCoin hooks in sequence, stops at the first error
+func (h MultiCronosHooks) AfterConvertCoin(ctx sdk.Context, sender sdk.AccAddress, contract common.Address, coin sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterConvertCoin(ctx, sender, contract, coin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
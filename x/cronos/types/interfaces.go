@@ -16,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/ethermint/x/evm/statedb"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -26,6 +27,7 @@ import (
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
@@ -50,6 +52,21 @@ type AccountKeeper interface {
 	SetAccount(ctx context.Context, account sdk.AccountI)
 }
 
+// DistributionKeeper defines the expected distribution keeper interface
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// StakingKeeper defines the expected staking keeper interface
+type StakingKeeper interface {
+	BondDenom(ctx context.Context) (string, error)
+}
+
+// ChannelKeeper defines the expected ibc channel keeper interface
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+}
+
 // EvmLogHandler defines the interface for evm log handler
 type EvmLogHandler interface {
 	// Return the id of the log signature it handles
@@ -62,6 +79,12 @@ type EvmLogHandler interface {
 // EvmKeeper defines the interface for evm keeper
 type EvmKeeper interface {
 	GetNonce(ctx sdk.Context, addr common.Address) uint64
+	GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account
+	ForEachStorage(ctx sdk.Context, addr common.Address, cb func(key, value common.Hash) bool)
+	GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash
+	SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte)
+	SetCode(ctx sdk.Context, codeHash, code []byte)
+	SetAccount(ctx sdk.Context, addr common.Address, account statedb.Account) error
 	ApplyMessage(ctx sdk.Context, msg *core.Message, tracer vm.EVMLogger, commit bool) (*evmtypes.MsgEthereumTxResponse, error)
 	GetParams(ctx sdk.Context) evmtypes.Params
 
@@ -84,6 +107,16 @@ type CronosKeeper interface {
 	GetParams(ctx sdk.Context) (params Params)
 }
 
+// CronosHooks event hooks for the conversions of the cronos module, they run in the same transaction as the
+// conversion, an error aborts the conversion.
+type CronosHooks interface {
+	// AfterConvertVouchers is called after the coins of the sender are converted to evm tokens of the recipient, the
+	// coins are net of the conversion fees
+	AfterConvertVouchers(ctx sdk.Context, sender sdk.AccAddress, recipient common.Address, coins sdk.Coins) error
+	// AfterConvertCoin is called after the coin of the sender is converted to crc20 tokens of the contract
+	AfterConvertCoin(ctx sdk.Context, sender sdk.AccAddress, contract common.Address, coin sdk.Coin) error
+}
+
 // IbcKeeper defines the interface for ibc keeper
 type IbcKeeper interface {
 	CreateClient(goCtx context.Context, msg *clienttypes.MsgCreateClient) (*clienttypes.MsgCreateClientResponse, error)
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -17,6 +18,9 @@ const (
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_cronos"
 
+	// ObjectStoreKey defines the store name for the object store, it's reset at the end of every block
+	ObjectStoreKey = "object:" + ModuleName
+
 	// this line is used by starport scaffolding # ibc/keys/name
 )
 
@@ -24,9 +28,14 @@ const (
 const (
 	prefixDenomToExternalContract = iota + 1
 	prefixDenomToAutoContract
-	prefixContractToDenom
+	// prefixLegacyContractToDenom is the reverse index keyed by the raw contract address, it's moved to
+	// prefixContractToDenom by the migration to the consensus version 3
+	prefixLegacyContractToDenom
 	paramsKey
 	prefixAdminToPermissions
+	prefixConvertedAmount
+	prefixContractToDenom
+	prefixConversionHistory
 )
 
 // KVStore key prefixes
@@ -37,6 +46,18 @@ var (
 	// ParamsKey is the key for params.
 	ParamsKey                   = []byte{paramsKey}
 	KeyPrefixAdminToPermissions = []byte{prefixAdminToPermissions}
+	KeyPrefixConvertedAmount    = []byte{prefixConvertedAmount}
+	KeyPrefixConversionHistory  = []byte{prefixConversionHistory}
+)
+
+// prefix bytes for the cronos object store
+const (
+	prefixDenomTraceCache = iota + 1
+)
+
+// ObjectStore key prefixes
+var (
+	KeyPrefixDenomTraceCache = []byte{prefixDenomTraceCache}
 )
 
 // this line is used by starport scaffolding # ibc/keys/port
@@ -51,12 +72,29 @@ func DenomToAutoContractKey(denom string) []byte {
 	return append(KeyPrefixDenomToAutoContract, denom...)
 }
 
-// ContractToDenomKey defines the store key for contract to denom reverse index
+// ContractToDenomKey defines the store key for contract to denom reverse index, the contract address is length
+// prefixed
 func ContractToDenomKey(contract []byte) []byte {
-	return append(KeyPrefixContractToDenom, contract...)
+	return append(KeyPrefixContractToDenom, address.MustLengthPrefix(contract)...)
+}
+
+// ConvertedAmountKey defines the store key for the amount of denom converted within the current quota epoch
+func ConvertedAmountKey(denom string) []byte {
+	return append(KeyPrefixConvertedAmount, denom...)
+}
+
+// ConversionHistoryKey defines the store key for the conversion history of an evm address, the address is length
+// prefixed
+func ConversionHistoryKey(addr []byte) []byte {
+	return append(KeyPrefixConversionHistory, address.MustLengthPrefix(addr)...)
 }
 
 // AdminToPermissionsKey defines the store key for admin to permissions mapping
 func AdminToPermissionsKey(address sdk.AccAddress) []byte {
 	return append(KeyPrefixAdminToPermissions, address.Bytes()...)
 }
+
+// DenomTraceCacheKey defines the object store key for the cached denom trace of a hash
+func DenomTraceCacheKey(hash []byte) []byte {
+	return append(KeyPrefixDenomTraceCache, hash...)
+}
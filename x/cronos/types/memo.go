@@ -0,0 +1,82 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MaxMemoLength is the maximum length of the ibc transfer memo parsed for the auto conversion
+const MaxMemoLength = 4096
+
+// ForwardMemoKey is the memo key of the packet forward middleware directive
+const ForwardMemoKey = "forward"
+
+// AutoConvertMemo is the structure of the ibc transfer memo recognized by the cronos module, e.g.
+// `{"cronos":{"auto_convert":true,"recipient":"0x..."}}`
+type AutoConvertMemo struct {
+	Cronos *AutoConvertOptions `json:"cronos,omitempty"`
+}
+
+// AutoConvertOptions defines how the received vouchers are converted
+type AutoConvertOptions struct {
+	AutoConvert bool `json:"auto_convert"`
+	// the evm address credited with the converted tokens, default to the receiver if empty
+	Recipient string `json:"recipient,omitempty"`
+}
+
+// ParseAutoConvertMemo returns the auto conversion options in the memo, nil if the memo is not intended for the
+// cronos module, an error is returned if it is but can't be parsed.
+func ParseAutoConvertMemo(memo string) (*AutoConvertOptions, error) {
+	memo = strings.TrimSpace(memo)
+	if !strings.HasPrefix(memo, "{") {
+		return nil, nil
+	}
+	if len(memo) > MaxMemoLength {
+		return nil, fmt.Errorf("memo length %d exceeds the maximum %d", len(memo), MaxMemoLength)
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &raw); err != nil {
+		// not a json object, must be intended for someone else
+		return nil, nil
+	}
+	if _, ok := raw[ModuleName]; !ok {
+		return nil, nil
+	}
+	if _, ok := raw[ForwardMemoKey]; ok {
+		// the packet doesn't terminate on cronos, the conversion is deferred to the final hop
+		return nil, nil
+	}
+
+	var parsed AutoConvertMemo
+	if err := json.Unmarshal([]byte(memo), &parsed); err != nil {
+		return nil, fmt.Errorf("invalid cronos memo: %w", err)
+	}
+	if parsed.Cronos == nil {
+		return nil, fmt.Errorf("invalid cronos memo: empty options")
+	}
+	if len(parsed.Cronos.Recipient) > 0 && !common.IsHexAddress(parsed.Cronos.Recipient) {
+		return nil, fmt.Errorf("invalid cronos memo: invalid recipient %s", parsed.Cronos.Recipient)
+	}
+	return parsed.Cronos, nil
+}
+
+// IsForwardMemo returns true if the memo contains a packet forward middleware directive, the packet only transits
+// through cronos in that case and the options intended for the next hops are nested in the directive, e.g.
+// `{"forward":{"receiver":"...","port":"transfer","channel":"channel-1","next":{"cronos":{"auto_convert":true}}}}`,
+// the cronos options are parsed once the packet terminates on this chain, with the memo it's forwarded with.
+func IsForwardMemo(memo string) bool {
+	memo = strings.TrimSpace(memo)
+	if !strings.HasPrefix(memo, "{") {
+		return false
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &raw); err != nil {
+		return false
+	}
+	forward, ok := raw[ForwardMemoKey]
+	return ok && string(forward) != "null"
+}
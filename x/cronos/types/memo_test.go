@@ -0,0 +1,97 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseAutoConvertMemo(t *testing.T) {
+	recipient := "0x2c2d2de15d7d6f3d7a1d1f2b5d3a9cd6f4c8e9a0"
+	tests := []struct {
+		name     string
+		memo     string
+		expected *AutoConvertOptions
+		success  bool
+	}{
+		{"empty memo", "", nil, true},
+		{"plain text memo", "hello", nil, true},
+		{"not a json object", "{hello", nil, true},
+		{"memo for another module", `{"forward":{"receiver":"cosmos1"}}`, nil, true},
+		{"auto convert", `{"cronos":{"auto_convert":true}}`, &AutoConvertOptions{AutoConvert: true}, true},
+		{
+			"auto convert with recipient",
+			`{"cronos":{"auto_convert":true,"recipient":"` + recipient + `"}}`,
+			&AutoConvertOptions{AutoConvert: true, Recipient: recipient},
+			true,
+		},
+		{"auto convert disabled", `{"cronos":{"auto_convert":false}}`, &AutoConvertOptions{}, true},
+		{"null options", `{"cronos":null}`, nil, false},
+		{"invalid options", `{"cronos":{"auto_convert":"yes"}}`, nil, false},
+		{"invalid recipient", `{"cronos":{"auto_convert":true,"recipient":"0x123"}}`, nil, false},
+		{
+			// the options nested in the forward directive are intended for the final hop
+			"forwarded memo with nested cronos options",
+			`{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","next":{"cronos":{"auto_convert":true}}}}`,
+			nil,
+			true,
+		},
+		{
+			"forwarded memo with cronos options",
+			`{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1"},"cronos":{"auto_convert":true}}`,
+			nil,
+			true,
+		},
+		{"oversized memo", `{"cronos":{"auto_convert":true},"pad":"` + strings.Repeat("a", MaxMemoLength) + `"}`, nil, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := ParseAutoConvertMemo(tt.memo)
+			if !tt.success {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, opts)
+		})
+	}
+}
+
+func Test_IsForwardMemo(t *testing.T) {
+	tests := []struct {
+		name     string
+		memo     string
+		expected bool
+	}{
+		{"empty memo", "", false},
+		{"plain text memo", "hello", false},
+		{"not a json object", "{hello", false},
+		{"cronos memo", `{"cronos":{"auto_convert":true}}`, false},
+		{"null forward", `{"forward":null}`, false},
+		{"forward", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1"}}`, true},
+		{
+			"forward with nested cronos options",
+			`{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","next":{"cronos":{"auto_convert":true}}}}`,
+			true,
+		},
+		{
+			// the packet forward middleware also accepts the next memo as a json string
+			"forward with nested cronos options string",
+			`{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","next":"{\"cronos\":{\"auto_convert\":true}}"}}`,
+			true,
+		},
+		{
+			"oversized forward",
+			`{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","next":{"pad":"` + strings.Repeat("a", MaxMemoLength) + `"}}}`,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, IsForwardMemo(tt.memo))
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -12,8 +14,11 @@ const (
 	TypeMsgTransferTokens     = "TransferTokens"
 	TypeMsgUpdateTokenMapping = "UpdateTokenMapping"
 	TypeMsgUpdateParams       = "UpdateParams"
+	TypeMsgRedeployContract   = "RedeployContract"
+	TypeMsgConvertAndTransfer = "ConvertAndTransfer"
 	TypeMsgTurnBridge         = "TurnBridge"
 	TypeMsgUpdatePermissions  = "UpdatePermissions"
+	TypeMsgConvertCoin        = "ConvertCoin"
 )
 
 var (
@@ -21,8 +26,11 @@ var (
 	_ sdk.Msg = &MsgTransferTokens{}
 	_ sdk.Msg = &MsgUpdateTokenMapping{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgRedeployContract{}
+	_ sdk.Msg = &MsgConvertAndTransfer{}
 	_ sdk.Msg = &MsgTurnBridge{}
 	_ sdk.Msg = &MsgUpdatePermissions{}
+	_ sdk.Msg = &MsgConvertCoin{}
 )
 
 func NewMsgConvertVouchers(address string, coins sdk.Coins) *MsgConvertVouchers {
@@ -70,6 +78,10 @@ func (msg *MsgConvertVouchers) ValidateBasic() error {
 	if !msg.Coins.IsAllPositive() {
 		return errors.Wrap(sdkerrors.ErrInvalidCoins, msg.Coins.String())
 	}
+
+	if msg.Recipient != "" && !common.IsHexAddress(msg.Recipient) {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", msg.Recipient)
+	}
 	return nil
 }
 
@@ -116,6 +128,69 @@ func (msg *MsgTransferTokens) ValidateBasic() error {
 	}
 
 	// TODO, validate TO address format
+	if len(msg.To) > transfertypes.MaximumReceiverLength {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "receiver address must not exceed %d bytes", transfertypes.MaximumReceiverLength)
+	}
+
+	if !msg.Coins.IsValid() {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, msg.Coins.String())
+	}
+
+	if !msg.Coins.IsAllPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, msg.Coins.String())
+	}
+
+	if msg.ChannelId != "" && !channeltypes.IsValidChannelID(msg.ChannelId) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel id (%s)", msg.ChannelId)
+	}
+
+	if len(msg.Memo) > transfertypes.MaximumMemoLength {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "memo must not exceed %d bytes", transfertypes.MaximumMemoLength)
+	}
+	return nil
+}
+
+// NewMsgConvertAndTransfer ...
+func NewMsgConvertAndTransfer(address string, coins sdk.Coins, to string, channelID string) *MsgConvertAndTransfer {
+	return &MsgConvertAndTransfer{
+		Address:   address,
+		Coins:     coins,
+		Transfer:  true,
+		To:        to,
+		ChannelId: channelID,
+	}
+}
+
+// Route ...
+func (msg MsgConvertAndTransfer) Route() string {
+	return RouterKey
+}
+
+// Type ...
+func (msg MsgConvertAndTransfer) Type() string {
+	return TypeMsgConvertAndTransfer
+}
+
+// GetSigners ...
+func (msg *MsgConvertAndTransfer) GetSigners() []sdk.AccAddress {
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{address}
+}
+
+// GetSignBytes ...
+func (msg *MsgConvertAndTransfer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic ...
+func (msg *MsgConvertAndTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
 
 	if !msg.Coins.IsValid() {
 		return errors.Wrap(sdkerrors.ErrInvalidCoins, msg.Coins.String())
@@ -124,6 +199,27 @@ func (msg *MsgTransferTokens) ValidateBasic() error {
 	if !msg.Coins.IsAllPositive() {
 		return errors.Wrap(sdkerrors.ErrInvalidCoins, msg.Coins.String())
 	}
+
+	// the transfer fields are ignored when the tokens are only converted
+	if !msg.Transfer {
+		return nil
+	}
+
+	if len(msg.To) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, "receiver address cannot be empty")
+	}
+
+	if len(msg.To) > transfertypes.MaximumReceiverLength {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "receiver address must not exceed %d bytes", transfertypes.MaximumReceiverLength)
+	}
+
+	if msg.ChannelId != "" && !channeltypes.IsValidChannelID(msg.ChannelId) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel id (%s)", msg.ChannelId)
+	}
+
+	if len(msg.Memo) > transfertypes.MaximumMemoLength {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "memo must not exceed %d bytes", transfertypes.MaximumMemoLength)
+	}
 	return nil
 }
 
@@ -160,8 +256,16 @@ func (msg *MsgUpdateTokenMapping) ValidateBasic() error {
 		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom format (%s)", msg.Denom)
 	}
 
-	if !common.IsHexAddress(msg.Contract) {
-		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid contract address (%s)", msg.Contract)
+	if len(msg.Contract) == 0 {
+		// an empty contract deletes the mapping, the contract of a source denom is implied by the denom
+		if IsSourceCoin(msg.Denom) {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "the mapping of source denom %s can't be deleted", msg.Denom)
+		}
+		return nil
+	}
+
+	if _, err := ParseContractAddress(msg.Contract); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
 	return nil
@@ -248,6 +352,10 @@ func (msg *MsgUpdateParams) ValidateBasic() error {
 		return errors.Wrap(err, "invalid authority address")
 	}
 
+	if len(msg.Params.CronosAdmins) == 0 {
+		return ErrCronosAdminEmpty
+	}
+
 	if err := msg.Params.Validate(); err != nil {
 		return err
 	}
@@ -271,6 +379,52 @@ func (msg *MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bz)
 }
 
+// NewMsgRedeployContract ...
+func NewMsgRedeployContract(authority string, denom string) *MsgRedeployContract {
+	return &MsgRedeployContract{
+		Authority: authority,
+		Denom:     denom,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgRedeployContract message.
+func (msg *MsgRedeployContract) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgRedeployContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	if !IsValidCoinDenom(msg.Denom) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom format (%s)", msg.Denom)
+	}
+
+	return nil
+}
+
+// Route ...
+func (msg MsgRedeployContract) Route() string {
+	return RouterKey
+}
+
+// Type ...
+func (msg MsgRedeployContract) Type() string {
+	return TypeMsgRedeployContract
+}
+
+// GetSignBytes ...
+func (msg *MsgRedeployContract) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
 // NewMsgUpdatePermissions ...
 func NewMsgUpdatePermissions(from string, address string, permissions uint64) *MsgUpdatePermissions {
 	return &MsgUpdatePermissions{
@@ -318,3 +472,52 @@ func (msg *MsgUpdatePermissions) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(msg)
 	return sdk.MustSortJSON(bz)
 }
+
+// NewMsgConvertCoin ...
+func NewMsgConvertCoin(sender string, coin sdk.Coin) *MsgConvertCoin {
+	return &MsgConvertCoin{
+		Sender: sender,
+		Coin:   coin,
+	}
+}
+
+// GetSigners ...
+func (msg *MsgConvertCoin) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic ...
+func (msg *MsgConvertCoin) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if !msg.Coin.IsValid() || !msg.Coin.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, msg.Coin.String())
+	}
+	if !IsValidCoinDenom(msg.Coin.Denom) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom format (%s)", msg.Coin.Denom)
+	}
+
+	return nil
+}
+
+// Route ...
+func (msg MsgConvertCoin) Route() string {
+	return RouterKey
+}
+
+// Type ...
+func (msg MsgConvertCoin) Type() string {
+	return TypeMsgConvertCoin
+}
+
+// GetSignBytes ...
+func (msg *MsgConvertCoin) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
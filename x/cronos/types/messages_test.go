@@ -2,8 +2,11 @@ package types_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/crypto-org-chain/cronos/v2/app"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 	"github.com/stretchr/testify/require"
@@ -42,6 +45,211 @@ func TestValidateMsgUpdateTokenMapping(t *testing.T) {
 			types.NewMsgUpdateTokenMapping("crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp", "gravity0x6E7eef2b30585B2A4D45Ba9312015d5354FDB067", "0x57f96e6B86CdeFdB3d4125", "", 0),
 			false,
 		},
+		{
+			"empty contract address deletes the mapping",
+			types.NewMsgUpdateTokenMapping("crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp", "gravity0x6E7eef2b30585B2A4D45Ba9312015d5354FDB067", "", "", 0),
+			true,
+		},
+		{
+			"empty contract address of source denom",
+			types.NewMsgUpdateTokenMapping("crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp", "cronos0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2", "", "", 0),
+			false,
+		},
+		{
+			"non hex contract address",
+			types.NewMsgUpdateTokenMapping("crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp", "gravity0x6E7eef2b30585B2A4D45Ba9312015d5354FDB067", "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9DZ", "", 0),
+			false,
+		},
+		{
+			"invalid contract address checksum",
+			types.NewMsgUpdateTokenMapping("crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp", "gravity0x6E7eef2b30585B2A4D45Ba9312015d5354FDB067", "0x57F96e6B86CdeFdB3d412547816a82E3E0EbF9D2", "", 0),
+			false,
+		},
+		{
+			"lowercase contract address",
+			types.NewMsgUpdateTokenMapping("crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp", "gravity0x6E7eef2b30585B2A4D45Ba9312015d5354FDB067", "0x57f96e6b86cdefdb3d412547816a82e3e0ebf9d2", "", 0),
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t1 *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expValid {
+				require.NoError(t1, err)
+			} else {
+				require.Error(t1, err)
+			}
+		})
+	}
+}
+
+func TestValidateMsgConvertVouchers(t *testing.T) {
+	// generated with the active bech32 prefix, so the test doesn't depend on the sdk config
+	sender := sdk.AccAddress([]byte("convert_voucher_addr")).String()
+	denom1 := "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+	denom2 := "ibc/1111111111111111111111111111111111111111111111111111111111111111"
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgConvertVouchers
+		expValid bool
+	}{
+		{
+			"valid single denom",
+			types.NewMsgConvertVouchers(sender, sdk.NewCoins(sdk.NewCoin(denom1, sdkmath.NewInt(1)))),
+			true,
+		},
+		{
+			"valid multiple denoms",
+			types.NewMsgConvertVouchers(sender, sdk.NewCoins(sdk.NewCoin(denom1, sdkmath.NewInt(1)), sdk.NewCoin(denom2, sdkmath.NewInt(2)))),
+			true,
+		},
+		{
+			"invalid sender",
+			types.NewMsgConvertVouchers(sender[:len(sender)-4], sdk.NewCoins(sdk.NewCoin(denom1, sdkmath.NewInt(1)))),
+			false,
+		},
+		{
+			"duplicate denoms",
+			types.NewMsgConvertVouchers(sender, sdk.Coins{sdk.NewCoin(denom1, sdkmath.NewInt(1)), sdk.NewCoin(denom1, sdkmath.NewInt(2))}),
+			false,
+		},
+		{
+			"zero amount mixed in",
+			types.NewMsgConvertVouchers(sender, sdk.Coins{sdk.NewCoin(denom1, sdkmath.NewInt(1)), sdk.NewCoin(denom2, sdkmath.ZeroInt())}),
+			false,
+		},
+		{
+			"empty coins",
+			types.NewMsgConvertVouchers(sender, sdk.Coins{}),
+			false,
+		},
+		{
+			"valid recipient",
+			&types.MsgConvertVouchers{Address: sender, Coins: sdk.NewCoins(sdk.NewCoin(denom1, sdkmath.NewInt(1))), Recipient: "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2"},
+			true,
+		},
+		{
+			"invalid recipient",
+			&types.MsgConvertVouchers{Address: sender, Coins: sdk.NewCoins(sdk.NewCoin(denom1, sdkmath.NewInt(1))), Recipient: "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9"},
+			false,
+		},
+		{
+			"bech32 recipient",
+			&types.MsgConvertVouchers{Address: sender, Coins: sdk.NewCoins(sdk.NewCoin(denom1, sdkmath.NewInt(1))), Recipient: sender},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t1 *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expValid {
+				require.NoError(t1, err)
+			} else {
+				require.Error(t1, err)
+			}
+		})
+	}
+}
+
+func TestValidateMsgConvertCoin(t *testing.T) {
+	sender := sdk.AccAddress([]byte("convert_coin_address")).String()
+	denom := "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgConvertCoin
+		expValid bool
+	}{
+		{"valid", types.NewMsgConvertCoin(sender, sdk.NewCoin(denom, sdkmath.NewInt(1))), true},
+		{"invalid sender", types.NewMsgConvertCoin(sender[:len(sender)-4], sdk.NewCoin(denom, sdkmath.NewInt(1))), false},
+		{"zero amount", types.NewMsgConvertCoin(sender, sdk.NewCoin(denom, sdkmath.ZeroInt())), false},
+		{"unsupported denom", types.NewMsgConvertCoin(sender, sdk.NewCoin("stake", sdkmath.NewInt(1))), false},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t1 *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expValid {
+				require.NoError(t1, err)
+			} else {
+				require.Error(t1, err)
+			}
+		})
+	}
+}
+
+func TestValidateMsgTransferTokens(t *testing.T) {
+	sender := sdk.AccAddress([]byte("transfer_tokens_addr")).String()
+	coins := sdk.NewCoins(sdk.NewCoin("ibc/0000000000000000000000000000000000000000000000000000000000000000", sdkmath.NewInt(1)))
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgTransferTokens
+		expValid bool
+	}{
+		{"valid without channel", types.NewMsgTransferTokens(sender, "to", coins), true},
+		{"valid channel", &types.MsgTransferTokens{From: sender, To: "to", Coins: coins, ChannelId: "channel-3"}, true},
+		{"invalid channel", &types.MsgTransferTokens{From: sender, To: "to", Coins: coins, ChannelId: "aaa"}, false},
+		{"invalid sender", types.NewMsgTransferTokens(sender[:len(sender)-4], "to", coins), false},
+		{"valid memo", &types.MsgTransferTokens{From: sender, To: "to", Coins: coins, Memo: "memo"}, true},
+		{"memo too long", &types.MsgTransferTokens{From: sender, To: "to", Coins: coins, Memo: strings.Repeat("a", 32769)}, false},
+		{"receiver too long", types.NewMsgTransferTokens(sender, strings.Repeat("a", 2049), coins), false},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t1 *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expValid {
+				require.NoError(t1, err)
+			} else {
+				require.Error(t1, err)
+			}
+		})
+	}
+}
+
+func TestValidateMsgConvertAndTransfer(t *testing.T) {
+	sender := sdk.AccAddress([]byte("convert_transfer_adr")).String()
+	coins := sdk.NewCoins(sdk.NewCoin("ibc/0000000000000000000000000000000000000000000000000000000000000000", sdkmath.NewInt(1)))
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgConvertAndTransfer
+		expValid bool
+	}{
+		{"valid", types.NewMsgConvertAndTransfer(sender, coins, "to", "channel-3"), true},
+		{"valid without channel", types.NewMsgConvertAndTransfer(sender, coins, "to", ""), true},
+		{"valid without transfer", &types.MsgConvertAndTransfer{Address: sender, Coins: coins}, true},
+		{"invalid sender", types.NewMsgConvertAndTransfer(sender[:len(sender)-4], coins, "to", ""), false},
+		{"invalid coins", types.NewMsgConvertAndTransfer(sender, sdk.Coins{}, "to", ""), false},
+		{"invalid channel", types.NewMsgConvertAndTransfer(sender, coins, "to", "aaa"), false},
+		{"empty receiver", types.NewMsgConvertAndTransfer(sender, coins, "", ""), false},
+		{"receiver too long", types.NewMsgConvertAndTransfer(sender, coins, strings.Repeat("a", 2049), ""), false},
+		{"memo too long", &types.MsgConvertAndTransfer{Address: sender, Coins: coins, Transfer: true, To: "to", Memo: strings.Repeat("a", 32769)}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t1 *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expValid {
+				require.NoError(t1, err)
+			} else {
+				require.Error(t1, err)
+			}
+		})
+	}
+}
+
+func TestValidateMsgRedeployContract(t *testing.T) {
+	authority := sdk.AccAddress([]byte("redeploy_authority__")).String()
+	denom := "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgRedeployContract
+		expValid bool
+	}{
+		{"valid", types.NewMsgRedeployContract(authority, denom), true},
+		{"invalid authority", types.NewMsgRedeployContract(authority[:len(authority)-4], denom), false},
+		{"unsupported denom", types.NewMsgRedeployContract(authority, "stake"), false},
 	}
 	for _, tc := range testCases {
 		t.Run(fmt.Sprintf("Case %s", tc.name), func(t1 *testing.T) {
@@ -1,10 +1,13 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
+	"slices"
 
 	yaml "gopkg.in/yaml.v2"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -14,18 +17,48 @@ var (
 	KeyIbcCroDenom = []byte("IbcCroDenom")
 	// KeyIbcTimeout is store's key for the IBC Timeout
 	KeyIbcTimeout = []byte("IbcTimeout")
-	// KeyCronosAdmin is store's key for the admin address
+	// KeyCronosAdmin is store's key for the admin addresses
 	KeyCronosAdmin = []byte("CronosAdmin")
 	// KeyEnableAutoDeployment is store's key for the EnableAutoDeployment
 	KeyEnableAutoDeployment = []byte("EnableAutoDeployment")
 	// KeyMaxCallbackGas is store's key for the MaxCallbackGas
 	KeyMaxCallbackGas = []byte("MaxCallbackGas")
+	// KeyConversionPaused is store's key for the ConversionPaused
+	KeyConversionPaused = []byte("ConversionPaused")
+	// KeyConversionQuotas is store's key for the ConversionQuotas
+	KeyConversionQuotas = []byte("ConversionQuotas")
+	// KeyQuotaEpochBlocks is store's key for the QuotaEpochBlocks
+	KeyQuotaEpochBlocks = []byte("QuotaEpochBlocks")
+	// KeyConversionFees is store's key for the ConversionFees
+	KeyConversionFees = []byte("ConversionFees")
+	// KeyConversionFeeCollector is store's key for the ConversionFeeCollector
+	KeyConversionFeeCollector = []byte("ConversionFeeCollector")
+	// KeyAutoDeployAllowlist is store's key for the AutoDeployAllowlist
+	KeyAutoDeployAllowlist = []byte("AutoDeployAllowlist")
+	// KeyEnableConversionHistory is store's key for the EnableConversionHistory
+	KeyEnableConversionHistory = []byte("EnableConversionHistory")
+	// KeyConversionHistorySize is store's key for the ConversionHistorySize
+	KeyConversionHistorySize = []byte("ConversionHistorySize")
+	// KeyConversionBlocklist is store's key for the ConversionBlocklist
+	KeyConversionBlocklist = []byte("ConversionBlocklist")
+	// KeyMinConversionAmount is store's key for the MinConversionAmount
+	KeyMinConversionAmount = []byte("MinConversionAmount")
+	// KeyMinConversionAmounts is store's key for the MinConversionAmounts
+	KeyMinConversionAmounts = []byte("MinConversionAmounts")
 )
 
 const (
 	IbcCroDenomDefaultValue    = "ibc/6B5A664BF0AF4F71B2F0BAA33141E2F1321242FBD5D19762F541EC971ACB0865"
 	IbcTimeoutDefaultValue     = uint64(86400000000000) // 1 day
 	MaxCallbackGasDefaultValue = uint64(50000)
+	// QuotaEpochBlocksDefaultValue is about one day with 6 seconds blocks
+	QuotaEpochBlocksDefaultValue = uint64(14400)
+	// MaxConversionFeeBasisPoints is the basis points of the whole amount, the fees must be lower
+	MaxConversionFeeBasisPoints = uint32(10000)
+	// ConversionHistorySizeDefaultValue is the number of conversions kept per evm address by default
+	ConversionHistorySizeDefaultValue = uint64(10)
+	// MaxConversionHistorySize bounds the conversions stored per evm address
+	MaxConversionHistorySize = uint64(100)
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -34,13 +67,14 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the cronos module
-func NewParams(ibcCroDenom string, ibcTimeout uint64, cronosAdmin string, enableAutoDeployment bool, maxCallbackGas uint64) Params {
+func NewParams(ibcCroDenom string, ibcTimeout uint64, cronosAdmins []string, enableAutoDeployment bool, maxCallbackGas uint64, conversionPaused bool) Params {
 	return Params{
 		IbcCroDenom:          ibcCroDenom,
 		IbcTimeout:           ibcTimeout,
-		CronosAdmin:          cronosAdmin,
+		CronosAdmins:         cronosAdmins,
 		EnableAutoDeployment: enableAutoDeployment,
 		MaxCallbackGas:       maxCallbackGas,
+		ConversionPaused:     conversionPaused,
 	}
 }
 
@@ -49,9 +83,20 @@ func DefaultParams() Params {
 	return Params{
 		IbcCroDenom:          IbcCroDenomDefaultValue,
 		IbcTimeout:           IbcTimeoutDefaultValue,
-		CronosAdmin:          "",
+		CronosAdmins:         nil,
 		EnableAutoDeployment: false,
 		MaxCallbackGas:       MaxCallbackGasDefaultValue,
+		ConversionPaused:     false,
+		ConversionQuotas:     nil,
+		QuotaEpochBlocks:     QuotaEpochBlocksDefaultValue,
+		ConversionFees:       nil,
+		AutoDeployAllowlist:  nil,
+		// the history is unbounded state, it's disabled by default
+		EnableConversionHistory: false,
+		ConversionHistorySize:   ConversionHistorySizeDefaultValue,
+		ConversionBlocklist:     nil,
+		MinConversionAmount:     sdkmath.ZeroInt(),
+		MinConversionAmounts:    nil,
 	}
 }
 
@@ -63,15 +108,93 @@ func (p Params) Validate() error {
 	if err := validateIsIbcDenom(p.IbcCroDenom); err != nil {
 		return err
 	}
-	if len(p.CronosAdmin) > 0 {
-		if _, err := sdk.AccAddressFromBech32(p.CronosAdmin); err != nil {
-			return err
-		}
+	if err := validateAdmins(p.CronosAdmins); err != nil {
+		return err
 	}
 	if err := validateIsUint64(p.MaxCallbackGas); err != nil {
 		return err
 	}
-	return nil
+	if err := validateConversionQuotas(p.ConversionQuotas); err != nil {
+		return err
+	}
+	if len(p.ConversionQuotas) > 0 && p.QuotaEpochBlocks == 0 {
+		return fmt.Errorf("quota epoch blocks must be positive when conversion quotas are set")
+	}
+	if err := validateConversionFees(p.ConversionFees); err != nil {
+		return err
+	}
+	if err := validateIsFeeCollector(p.ConversionFeeCollector); err != nil {
+		return err
+	}
+	if err := validateAutoDeployAllowlist(p.AutoDeployAllowlist); err != nil {
+		return err
+	}
+	if err := validateIsConversionHistorySize(p.ConversionHistorySize); err != nil {
+		return err
+	}
+	if p.EnableConversionHistory && p.ConversionHistorySize == 0 {
+		return fmt.Errorf("conversion history size must be positive when the conversion history is enabled")
+	}
+	if err := validateConversionBlocklist(p.ConversionBlocklist); err != nil {
+		return err
+	}
+	if err := validateIsMinConversionAmount(p.MinConversionAmount); err != nil {
+		return err
+	}
+	return validateMinConversionAmounts(p.MinConversionAmounts)
+}
+
+// IsCronosAdmin returns true if the address is one of the cronos admins
+func (p Params) IsCronosAdmin(address string) bool {
+	return slices.Contains(p.CronosAdmins, address)
+}
+
+// GetConversionQuota returns the conversion quota of the denom, found is false if the denom is not limited
+func (p Params) GetConversionQuota(denom string) (quota sdkmath.Int, found bool) {
+	for _, q := range p.ConversionQuotas {
+		if q.Denom == denom {
+			return q.Amount, true
+		}
+	}
+	return sdkmath.Int{}, false
+}
+
+// IsAutoDeployAllowed returns true if a contract can be auto-deployed for the denom, any denom is allowed if the
+// allowlist is empty
+func (p Params) IsAutoDeployAllowed(denom string) bool {
+	return len(p.AutoDeployAllowlist) == 0 || slices.Contains(p.AutoDeployAllowlist, denom)
+}
+
+// IsConversionBlocked returns true if the denom is on the conversion blocklist
+func (p Params) IsConversionBlocked(denom string) bool {
+	return slices.Contains(p.ConversionBlocklist, denom)
+}
+
+// GetMinConversionAmount returns the minimum amount of the denom converted to CRC20 tokens, the minimum of the denom
+// takes precedence over the default one, it's zero if there's no minimum.
+func (p Params) GetMinConversionAmount(denom string) sdkmath.Int {
+	for _, m := range p.MinConversionAmounts {
+		if m.Denom == denom {
+			return m.Amount
+		}
+	}
+	// the params stored before the minimum was introduced have no default one
+	if p.MinConversionAmount.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return p.MinConversionAmount
+}
+
+// GetConversionFee returns the fee charged on the conversion of the coin, rounded down,
+// it's zero if the denom has no fee.
+func (p Params) GetConversionFee(coin sdk.Coin) sdk.Coin {
+	for _, f := range p.ConversionFees {
+		if f.Denom == coin.Denom {
+			amount := coin.Amount.MulRaw(int64(f.BasisPoints)).QuoRaw(int64(MaxConversionFeeBasisPoints))
+			return sdk.NewCoin(coin.Denom, amount)
+		}
+	}
+	return sdk.NewCoin(coin.Denom, sdkmath.ZeroInt())
 }
 
 // String implements the fmt.Stringer interface
@@ -85,9 +208,20 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyIbcCroDenom, &p.IbcCroDenom, validateIsIbcDenom),
 		paramtypes.NewParamSetPair(KeyIbcTimeout, &p.IbcTimeout, validateIsUint64),
-		paramtypes.NewParamSetPair(KeyCronosAdmin, &p.CronosAdmin, validateIsAddress),
+		paramtypes.NewParamSetPair(KeyCronosAdmin, (*legacyAdmins)(&p.CronosAdmins), validateIsAdmins),
 		paramtypes.NewParamSetPair(KeyEnableAutoDeployment, &p.EnableAutoDeployment, validateIsBool),
 		paramtypes.NewParamSetPair(KeyMaxCallbackGas, &p.MaxCallbackGas, validateIsUint64),
+		paramtypes.NewParamSetPair(KeyConversionPaused, &p.ConversionPaused, validateIsBool),
+		paramtypes.NewParamSetPair(KeyConversionQuotas, &p.ConversionQuotas, validateIsConversionQuotas),
+		paramtypes.NewParamSetPair(KeyQuotaEpochBlocks, &p.QuotaEpochBlocks, validateIsUint64),
+		paramtypes.NewParamSetPair(KeyConversionFees, &p.ConversionFees, validateIsConversionFees),
+		paramtypes.NewParamSetPair(KeyConversionFeeCollector, &p.ConversionFeeCollector, validateIsFeeCollector),
+		paramtypes.NewParamSetPair(KeyAutoDeployAllowlist, &p.AutoDeployAllowlist, validateIsAutoDeployAllowlist),
+		paramtypes.NewParamSetPair(KeyEnableConversionHistory, &p.EnableConversionHistory, validateIsBool),
+		paramtypes.NewParamSetPair(KeyConversionHistorySize, &p.ConversionHistorySize, validateIsConversionHistorySize),
+		paramtypes.NewParamSetPair(KeyConversionBlocklist, &p.ConversionBlocklist, validateIsConversionBlocklist),
+		paramtypes.NewParamSetPair(KeyMinConversionAmount, &p.MinConversionAmount, validateIsMinConversionAmount),
+		paramtypes.NewParamSetPair(KeyMinConversionAmounts, &p.MinConversionAmounts, validateIsMinConversionAmounts),
 	}
 }
 
@@ -110,15 +244,212 @@ func validateIsUint64(i interface{}) error {
 	return nil
 }
 
-func validateIsAddress(i interface{}) error {
+func validateIsAdmins(i interface{}) error {
+	switch admins := i.(type) {
+	case legacyAdmins:
+		return validateAdmins(admins)
+	case []string:
+		return validateAdmins(admins)
+	default:
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+}
+
+func validateAdmins(admins []string) error {
+	seen := make(map[string]struct{}, len(admins))
+	for _, admin := range admins {
+		if _, err := sdk.AccAddressFromBech32(admin); err != nil {
+			return err
+		}
+		if _, ok := seen[admin]; ok {
+			return fmt.Errorf("duplicated cronos admin: %s", admin)
+		}
+		seen[admin] = struct{}{}
+	}
+	return nil
+}
+
+// legacyAdmins is the admins as stored in the legacy params subspace,
+// which used to be a single address, an empty one means no admin.
+type legacyAdmins []string
+
+// MarshalJSON implements json.Marshaler
+func (a legacyAdmins) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]string(a))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it accepts both a single address and a list
+func (a *legacyAdmins) UnmarshalJSON(bz []byte) error {
+	var admin string
+	if err := json.Unmarshal(bz, &admin); err == nil {
+		*a = nil
+		if len(admin) > 0 {
+			*a = legacyAdmins{admin}
+		}
+		return nil
+	}
+	var admins []string
+	if err := json.Unmarshal(bz, &admins); err != nil {
+		return err
+	}
+	*a = admins
+	return nil
+}
+
+func validateIsConversionQuotas(i interface{}) error {
+	quotas, ok := i.([]ConversionQuota)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return validateConversionQuotas(quotas)
+}
+
+func validateConversionQuotas(quotas []ConversionQuota) error {
+	seen := make(map[string]struct{}, len(quotas))
+	for _, quota := range quotas {
+		if err := sdk.ValidateDenom(quota.Denom); err != nil {
+			return err
+		}
+		if _, ok := seen[quota.Denom]; ok {
+			return fmt.Errorf("duplicated conversion quota: %s", quota.Denom)
+		}
+		seen[quota.Denom] = struct{}{}
+		// a zero quota prevents the conversions of the denom
+		if quota.Amount.IsNil() || quota.Amount.IsNegative() {
+			return fmt.Errorf("invalid conversion quota of %s: %s", quota.Denom, quota.Amount)
+		}
+	}
+	return nil
+}
+
+func validateIsConversionFees(i interface{}) error {
+	fees, ok := i.([]ConversionFee)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return validateConversionFees(fees)
+}
+
+func validateConversionFees(fees []ConversionFee) error {
+	seen := make(map[string]struct{}, len(fees))
+	for _, fee := range fees {
+		if err := sdk.ValidateDenom(fee.Denom); err != nil {
+			return err
+		}
+		if _, ok := seen[fee.Denom]; ok {
+			return fmt.Errorf("duplicated conversion fee: %s", fee.Denom)
+		}
+		seen[fee.Denom] = struct{}{}
+		// at least part of the amount must be converted
+		if fee.BasisPoints >= MaxConversionFeeBasisPoints {
+			return fmt.Errorf("invalid conversion fee of %s: %d basis points", fee.Denom, fee.BasisPoints)
+		}
+	}
+	return nil
+}
+
+func validateIsFeeCollector(i interface{}) error {
 	s, ok := i.(string)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	if len(s) > 0 {
-		if _, err := sdk.AccAddressFromBech32(s); err != nil {
+	// the fees are paid to the community pool without a collector
+	if s == "" {
+		return nil
+	}
+	_, err := sdk.AccAddressFromBech32(s)
+	return err
+}
+
+func validateIsAutoDeployAllowlist(i interface{}) error {
+	denoms, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return validateAutoDeployAllowlist(denoms)
+}
+
+func validateAutoDeployAllowlist(denoms []string) error {
+	seen := make(map[string]struct{}, len(denoms))
+	for _, denom := range denoms {
+		if !IsValidCoinDenom(denom) {
+			return fmt.Errorf("invalid auto-deploy denom: %s", denom)
+		}
+		if _, ok := seen[denom]; ok {
+			return fmt.Errorf("duplicated auto-deploy denom: %s", denom)
+		}
+		seen[denom] = struct{}{}
+	}
+	return nil
+}
+
+func validateIsConversionBlocklist(i interface{}) error {
+	denoms, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return validateConversionBlocklist(denoms)
+}
+
+func validateConversionBlocklist(denoms []string) error {
+	seen := make(map[string]struct{}, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
 			return err
 		}
+		if _, ok := seen[denom]; ok {
+			return fmt.Errorf("duplicated blocklisted denom: %s", denom)
+		}
+		seen[denom] = struct{}{}
+	}
+	return nil
+}
+
+func validateIsMinConversionAmount(i interface{}) error {
+	amount, ok := i.(sdkmath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// an unset minimum doesn't limit the conversions
+	if !amount.IsNil() && amount.IsNegative() {
+		return fmt.Errorf("invalid minimum conversion amount: %s", amount)
+	}
+	return nil
+}
+
+func validateIsMinConversionAmounts(i interface{}) error {
+	minimums, ok := i.([]ConversionMinimum)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return validateMinConversionAmounts(minimums)
+}
+
+func validateMinConversionAmounts(minimums []ConversionMinimum) error {
+	seen := make(map[string]struct{}, len(minimums))
+	for _, m := range minimums {
+		if err := sdk.ValidateDenom(m.Denom); err != nil {
+			return err
+		}
+		if _, ok := seen[m.Denom]; ok {
+			return fmt.Errorf("duplicated minimum conversion amount: %s", m.Denom)
+		}
+		seen[m.Denom] = struct{}{}
+		// a zero minimum exempts the denom from the default one
+		if m.Amount.IsNil() || m.Amount.IsNegative() {
+			return fmt.Errorf("invalid minimum conversion amount of %s: %s", m.Denom, m.Amount)
+		}
+	}
+	return nil
+}
+
+func validateIsConversionHistorySize(i interface{}) error {
+	size, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if size > MaxConversionHistorySize {
+		return fmt.Errorf("conversion history size %d exceeds the maximum %d", size, MaxConversionHistorySize)
 	}
 	return nil
 }
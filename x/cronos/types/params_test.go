@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
@@ -72,7 +73,7 @@ func Test_validateIsBool(t *testing.T) {
 	}
 }
 
-func Test_validateIsAddress(t *testing.T) {
+func Test_validateIsAdmins(t *testing.T) {
 	config := sdk.GetConfig()
 	config.SetBech32PrefixForAccount("crc", "crc"+sdk.PrefixPublic)
 
@@ -84,14 +85,235 @@ func Test_validateIsAddress(t *testing.T) {
 		args    args
 		wantErr bool
 	}{
-		{"invalid address", args{"a"}, true},
-		{"invalid bech32 prefix", args{"tcrc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp"}, true},
-		{"correct bech32 address", args{"crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp"}, false},
+		{"invalid type", args{"crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp"}, true},
+		{"invalid address", args{[]string{"a"}}, true},
+		{"invalid bech32 prefix", args{[]string{"tcrc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp"}}, true},
+		{"empty address", args{[]string{""}}, true},
+		{"no admin", args{[]string{}}, false},
+		{"correct bech32 address", args{[]string{"crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp"}}, false},
+		{"correct legacy admins", args{legacyAdmins{"crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp"}}, false},
+		{"multiple admins", args{[]string{
+			"crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp",
+			"crc18z6q38mhvtsvyr5mak8fj8s8g4gw7kjjtsgrn7",
+		}}, false},
+		{"duplicated admins", args{[]string{
+			"crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp",
+			"crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp",
+		}}, true},
 	}
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
-			require.Equal(t, tt.wantErr, validateIsAddress(tt.args.i) != nil)
+			require.Equal(t, tt.wantErr, validateIsAdmins(tt.args.i) != nil)
 		})
 	}
 }
+
+func Test_legacyAdminsJSON(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	tests := []struct {
+		name     string
+		json     string
+		expected legacyAdmins
+	}{
+		{"single address", `"crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp"`, legacyAdmins{"crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp"}},
+		{"empty address", `""`, nil},
+		{"list", `["a","b"]`, legacyAdmins{"a", "b"}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var admins legacyAdmins
+			require.NoError(t, cdc.UnmarshalJSON([]byte(tt.json), &admins))
+			require.Equal(t, tt.expected, admins)
+		})
+	}
+}
+
+func Test_validateIsConversionQuotas(t *testing.T) {
+	denom := "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+	type args struct {
+		i interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{"invalid type", args{"a"}, true},
+		{"no quota", args{[]ConversionQuota{}}, false},
+		{"correct quota", args{[]ConversionQuota{{Denom: denom, Amount: sdkmath.NewInt(100)}}}, false},
+		{"zero quota", args{[]ConversionQuota{{Denom: denom, Amount: sdkmath.ZeroInt()}}}, false},
+		{"negative quota", args{[]ConversionQuota{{Denom: denom, Amount: sdkmath.NewInt(-1)}}}, true},
+		{"nil quota", args{[]ConversionQuota{{Denom: denom}}}, true},
+		{"invalid denom", args{[]ConversionQuota{{Denom: "a", Amount: sdkmath.NewInt(100)}}}, true},
+		{"duplicated denoms", args{[]ConversionQuota{
+			{Denom: denom, Amount: sdkmath.NewInt(100)},
+			{Denom: denom, Amount: sdkmath.NewInt(200)},
+		}}, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantErr, validateIsConversionQuotas(tt.args.i) != nil)
+		})
+	}
+}
+
+func Test_validateIsConversionFees(t *testing.T) {
+	denom := "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+	type args struct {
+		i interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{"invalid type", args{"a"}, true},
+		{"no fee", args{[]ConversionFee{}}, false},
+		{"zero fee", args{[]ConversionFee{{Denom: denom, BasisPoints: 0}}}, false},
+		{"correct fee", args{[]ConversionFee{{Denom: denom, BasisPoints: 9999}}}, false},
+		{"fee of 100%", args{[]ConversionFee{{Denom: denom, BasisPoints: 10000}}}, true},
+		{"invalid denom", args{[]ConversionFee{{Denom: "a", BasisPoints: 10}}}, true},
+		{"duplicated denoms", args{[]ConversionFee{
+			{Denom: denom, BasisPoints: 10},
+			{Denom: denom, BasisPoints: 20},
+		}}, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantErr, validateIsConversionFees(tt.args.i) != nil)
+		})
+	}
+}
+
+func Test_validateIsAutoDeployAllowlist(t *testing.T) {
+	denom := "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+	tests := []struct {
+		name    string
+		i       interface{}
+		wantErr bool
+	}{
+		{"invalid type", "a", true},
+		{"empty allowlist", []string{}, false},
+		{"correct allowlist", []string{denom, "gravity0x0000000000000000000000000000000000000000"}, false},
+		{"invalid denom", []string{"a"}, true},
+		{"duplicated denoms", []string{denom, denom}, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantErr, validateIsAutoDeployAllowlist(tt.i) != nil)
+		})
+	}
+
+	params := DefaultParams()
+	require.True(t, params.IsAutoDeployAllowed(denom))
+	params.AutoDeployAllowlist = []string{IbcCroDenomDefaultValue}
+	require.False(t, params.IsAutoDeployAllowed(denom))
+	require.True(t, params.IsAutoDeployAllowed(IbcCroDenomDefaultValue))
+}
+
+func TestParamsGetConversionFee(t *testing.T) {
+	params := DefaultParams()
+	params.ConversionFees = []ConversionFee{{Denom: IbcCroDenomDefaultValue, BasisPoints: 30}}
+	require.Equal(t, sdkmath.NewInt(2), params.GetConversionFee(sdk.NewCoin(IbcCroDenomDefaultValue, sdkmath.NewInt(999))).Amount)
+	require.True(t, params.GetConversionFee(sdk.NewCoin(IbcCroDenomDefaultValue, sdkmath.NewInt(333))).IsZero())
+	// the denoms without a fee are converted in full
+	require.True(t, params.GetConversionFee(sdk.NewCoin("stake", sdkmath.NewInt(999))).IsZero())
+
+	params.ConversionFeeCollector = "invalid"
+	require.Error(t, params.Validate())
+}
+
+func TestParamsValidateQuotaEpochBlocks(t *testing.T) {
+	params := DefaultParams()
+	params.ConversionQuotas = []ConversionQuota{{Denom: IbcCroDenomDefaultValue, Amount: sdkmath.NewInt(100)}}
+	require.NoError(t, params.Validate())
+	params.QuotaEpochBlocks = 0
+	require.Error(t, params.Validate())
+	// the epoch is not used without quotas
+	params.ConversionQuotas = nil
+	require.NoError(t, params.Validate())
+}
+
+func TestParamsValidateConversionHistory(t *testing.T) {
+	params := DefaultParams()
+	require.False(t, params.EnableConversionHistory)
+	params.EnableConversionHistory = true
+	require.NoError(t, params.Validate())
+	params.ConversionHistorySize = MaxConversionHistorySize
+	require.NoError(t, params.Validate())
+	params.ConversionHistorySize = MaxConversionHistorySize + 1
+	require.Error(t, params.Validate())
+	params.ConversionHistorySize = 0
+	require.Error(t, params.Validate())
+	// the size is not used while the history is disabled
+	params.EnableConversionHistory = false
+	require.NoError(t, params.Validate())
+	require.Error(t, validateIsConversionHistorySize(uint32(1)))
+}
+
+func Test_validateIsConversionBlocklist(t *testing.T) {
+	tests := []struct {
+		name    string
+		i       interface{}
+		wantErr bool
+	}{
+		{"invalid type", "a", true},
+		{"empty blocklist", []string{}, false},
+		{"correct blocklist", []string{"stake", IbcCroDenomDefaultValue}, false},
+		{"invalid denom", []string{"1"}, true},
+		{"duplicated denoms", []string{"stake", "stake"}, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantErr, validateIsConversionBlocklist(tt.i) != nil)
+		})
+	}
+
+	params := DefaultParams()
+	require.False(t, params.IsConversionBlocked("stake"))
+	params.ConversionBlocklist = []string{"stake"}
+	require.True(t, params.IsConversionBlocked("stake"))
+	require.False(t, params.IsConversionBlocked(IbcCroDenomDefaultValue))
+}
+
+func Test_validateIsMinConversionAmounts(t *testing.T) {
+	tests := []struct {
+		name    string
+		i       interface{}
+		wantErr bool
+	}{
+		{"invalid type", "a", true},
+		{"empty minimums", []ConversionMinimum{}, false},
+		{"correct minimums", []ConversionMinimum{{"stake", sdkmath.NewInt(100)}, {IbcCroDenomDefaultValue, sdkmath.ZeroInt()}}, false},
+		{"invalid denom", []ConversionMinimum{{"1", sdkmath.NewInt(100)}}, true},
+		{"duplicated denoms", []ConversionMinimum{{"stake", sdkmath.NewInt(100)}, {"stake", sdkmath.NewInt(200)}}, true},
+		{"negative minimum", []ConversionMinimum{{"stake", sdkmath.NewInt(-1)}}, true},
+		{"nil minimum", []ConversionMinimum{{Denom: "stake"}}, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantErr, validateIsMinConversionAmounts(tt.i) != nil)
+		})
+	}
+
+	require.Error(t, validateIsMinConversionAmount(uint64(1)))
+	require.Error(t, validateIsMinConversionAmount(sdkmath.NewInt(-1)))
+	require.NoError(t, validateIsMinConversionAmount(sdkmath.Int{}))
+
+	params := DefaultParams()
+	require.True(t, params.GetMinConversionAmount("stake").IsZero())
+	// the params stored without the default minimum
+	params.MinConversionAmount = sdkmath.Int{}
+	require.True(t, params.GetMinConversionAmount("stake").IsZero())
+	params.MinConversionAmount = sdkmath.NewInt(1000)
+	params.MinConversionAmounts = []ConversionMinimum{{"stake", sdkmath.NewInt(100)}}
+	require.Equal(t, sdkmath.NewInt(100), params.GetMinConversionAmount("stake"))
+	require.Equal(t, sdkmath.NewInt(1000), params.GetMinConversionAmount(IbcCroDenomDefaultValue))
+}
@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -208,6 +211,177 @@ func (m *DenomByContractResponse) GetDenom() string {
 	return ""
 }
 
+// QueryTokenMappingsRequest is the request type of TokenMappings call
+type QueryTokenMappingsRequest struct {
+	// pagination defines an optional pagination for the request, the key is the denom.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTokenMappingsRequest) Reset()         { *m = QueryTokenMappingsRequest{} }
+func (m *QueryTokenMappingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenMappingsRequest) ProtoMessage()    {}
+func (*QueryTokenMappingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ed0fd688c48372, []int{4}
+}
+func (m *QueryTokenMappingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenMappingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenMappingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenMappingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenMappingsRequest.Merge(m, src)
+}
+func (m *QueryTokenMappingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenMappingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenMappingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenMappingsRequest proto.InternalMessageInfo
+
+func (m *QueryTokenMappingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTokenMappingsResponse is the response type of TokenMappings call
+type QueryTokenMappingsResponse struct {
+	Mappings []TokenMappingInfo `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTokenMappingsResponse) Reset()         { *m = QueryTokenMappingsResponse{} }
+func (m *QueryTokenMappingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenMappingsResponse) ProtoMessage()    {}
+func (*QueryTokenMappingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ed0fd688c48372, []int{5}
+}
+func (m *QueryTokenMappingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenMappingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenMappingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenMappingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenMappingsResponse.Merge(m, src)
+}
+func (m *QueryTokenMappingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenMappingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenMappingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenMappingsResponse proto.InternalMessageInfo
+
+func (m *QueryTokenMappingsResponse) GetMappings() []TokenMappingInfo {
+	if m != nil {
+		return m.Mappings
+	}
+	return nil
+}
+
+func (m *QueryTokenMappingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// TokenMappingInfo defines a token mapping entry returned by TokenMappings call
+type TokenMappingInfo struct {
+	Denom    string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// the token is originated from cronos
+	IsSource bool `protobuf:"varint,3,opt,name=is_source,json=isSource,proto3" json:"is_source,omitempty"`
+	// the contract is deployed automatically by the module
+	AutoDeployed bool `protobuf:"varint,4,opt,name=auto_deployed,json=autoDeployed,proto3" json:"auto_deployed,omitempty"`
+}
+
+func (m *TokenMappingInfo) Reset()         { *m = TokenMappingInfo{} }
+func (m *TokenMappingInfo) String() string { return proto.CompactTextString(m) }
+func (*TokenMappingInfo) ProtoMessage()    {}
+func (*TokenMappingInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ed0fd688c48372, []int{6}
+}
+func (m *TokenMappingInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenMappingInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenMappingInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenMappingInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenMappingInfo.Merge(m, src)
+}
+func (m *TokenMappingInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenMappingInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenMappingInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenMappingInfo proto.InternalMessageInfo
+
+func (m *TokenMappingInfo) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TokenMappingInfo) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *TokenMappingInfo) GetIsSource() bool {
+	if m != nil {
+		return m.IsSource
+	}
+	return false
+}
+
+func (m *TokenMappingInfo) GetAutoDeployed() bool {
+	if m != nil {
+		return m.AutoDeployed
+	}
+	return false
+}
+
 // ReplayBlockRequest
 type ReplayBlockRequest struct {
 	// the eth messages in the block
@@ -221,7 +395,7 @@ func (m *ReplayBlockRequest) Reset()         { *m = ReplayBlockRequest{} }
 func (m *ReplayBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*ReplayBlockRequest) ProtoMessage()    {}
 func (*ReplayBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4ed0fd688c48372, []int{4}
+	return fileDescriptor_d4ed0fd688c48372, []int{7}
 }
 func (m *ReplayBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -259,7 +433,7 @@ func (m *ReplayBlockResponse) Reset()         { *m = ReplayBlockResponse{} }
 func (m *ReplayBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*ReplayBlockResponse) ProtoMessage()    {}
 func (*ReplayBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4ed0fd688c48372, []int{5}
+	return fileDescriptor_d4ed0fd688c48372, []int{8}
 }
 func (m *ReplayBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -303,7 +477,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4ed0fd688c48372, []int{6}
+	return fileDescriptor_d4ed0fd688c48372, []int{9}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -342,7 +516,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4ed0fd688c48372, []int{7}
+	return fileDescriptor_d4ed0fd688c48372, []int{10}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,7 +562,7 @@ func (m *QueryPermissionsRequest) Reset()         { *m = QueryPermissionsRequest
 func (m *QueryPermissionsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPermissionsRequest) ProtoMessage()    {}
 func (*QueryPermissionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4ed0fd688c48372, []int{8}
+	return fileDescriptor_d4ed0fd688c48372, []int{11}
 }
 func (m *QueryPermissionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -435,7 +609,7 @@ func (m *QueryPermissionsResponse) Reset()         { *m = QueryPermissionsRespon
 func (m *QueryPermissionsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPermissionsResponse) ProtoMessage()    {}
 func (*QueryPermissionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4ed0fd688c48372, []int{9}
+	return fileDescriptor_d4ed0fd688c48372, []int{12}
 }
 func (m *QueryPermissionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,821 +652,4924 @@ func (m *QueryPermissionsResponse) GetCanTurnBridge() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*ContractByDenomRequest)(nil), "cronos.ContractByDenomRequest")
-	proto.RegisterType((*ContractByDenomResponse)(nil), "cronos.ContractByDenomResponse")
-	proto.RegisterType((*DenomByContractRequest)(nil), "cronos.DenomByContractRequest")
-	proto.RegisterType((*DenomByContractResponse)(nil), "cronos.DenomByContractResponse")
-	proto.RegisterType((*ReplayBlockRequest)(nil), "cronos.ReplayBlockRequest")
-	proto.RegisterType((*ReplayBlockResponse)(nil), "cronos.ReplayBlockResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "cronos.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cronos.QueryParamsResponse")
-	proto.RegisterType((*QueryPermissionsRequest)(nil), "cronos.QueryPermissionsRequest")
-	proto.RegisterType((*QueryPermissionsResponse)(nil), "cronos.QueryPermissionsResponse")
+// QuerySimulateConversionRequest is the request type for the Query/SimulateConversion RPC method.
+type QuerySimulateConversionRequest struct {
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func init() { proto.RegisterFile("cronos/query.proto", fileDescriptor_d4ed0fd688c48372) }
-
-var fileDescriptor_d4ed0fd688c48372 = []byte{
-	// 780 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x4f, 0x4f, 0xe3, 0x46,
-	0x14, 0x8f, 0x21, 0xa4, 0x61, 0x02, 0x45, 0x9d, 0x50, 0x30, 0x6e, 0x6b, 0xa7, 0x6e, 0x05, 0x54,
-	0x02, 0x5b, 0x84, 0x4a, 0xad, 0x7a, 0xe8, 0x21, 0x29, 0x12, 0x17, 0x50, 0x6b, 0xe5, 0x84, 0x90,
-	0xac, 0x89, 0x33, 0x75, 0x2c, 0xf0, 0x8c, 0xf1, 0x8c, 0x23, 0x22, 0x84, 0x54, 0xb5, 0x97, 0x1e,
-	0x91, 0xfa, 0x05, 0x38, 0xf7, 0x93, 0x70, 0x44, 0xea, 0xa5, 0xea, 0xa1, 0xbb, 0x82, 0x3d, 0xec,
-	0xc7, 0x58, 0x79, 0x3c, 0x93, 0x04, 0x12, 0x76, 0x4f, 0x9e, 0xf9, 0xfd, 0xde, 0x9f, 0xdf, 0x9b,
-	0xf7, 0x9e, 0x01, 0x0c, 0x52, 0x4a, 0x28, 0x73, 0x2f, 0x32, 0x9c, 0x0e, 0x9d, 0x24, 0xa5, 0x9c,
-	0xc2, 0x4a, 0x81, 0x19, 0xab, 0x21, 0x0d, 0xa9, 0x80, 0xdc, 0xfc, 0x54, 0xb0, 0xc6, 0xe7, 0x21,
-	0xa5, 0xe1, 0x39, 0x76, 0x51, 0x12, 0xb9, 0x88, 0x10, 0xca, 0x11, 0x8f, 0x28, 0x61, 0x92, 0xb5,
-	0x24, 0x2b, 0x6e, 0xdd, 0xec, 0x57, 0x97, 0x47, 0x31, 0x66, 0x1c, 0xc5, 0x89, 0x34, 0xd8, 0xc0,
-	0xbc, 0x8f, 0xd3, 0x38, 0x22, 0xdc, 0xc5, 0x83, 0xd8, 0x1d, 0xec, 0xb9, 0xfc, 0x52, 0x52, 0x75,
-	0xa9, 0xa5, 0xf8, 0x14, 0xa0, 0xfd, 0x3d, 0x58, 0x6b, 0x53, 0xc2, 0x53, 0x14, 0xf0, 0xd6, 0xf0,
-	0x27, 0x4c, 0x68, 0xec, 0xe1, 0x8b, 0x0c, 0x33, 0x0e, 0x57, 0xc1, 0x42, 0x2f, 0xbf, 0xeb, 0x5a,
-	0x43, 0xdb, 0x5e, 0xf4, 0x8a, 0xcb, 0x0f, 0xd5, 0x3f, 0x6f, 0xad, 0xd2, 0xdb, 0x5b, 0xab, 0x64,
-	0x9f, 0x80, 0xf5, 0x29, 0x4f, 0x96, 0x50, 0xc2, 0x30, 0x34, 0x40, 0x35, 0x90, 0x94, 0xf4, 0x1e,
-	0xdd, 0xe1, 0x57, 0x60, 0x19, 0x65, 0x9c, 0xfa, 0x23, 0x83, 0x39, 0x61, 0xb0, 0x94, 0x83, 0x2a,
-	0x9e, 0xfd, 0x23, 0x58, 0x13, 0x11, 0x5b, 0x43, 0x05, 0x29, 0x55, 0xef, 0x09, 0x3d, 0xa1, 0xcd,
-	0x05, 0xeb, 0x53, 0xfe, 0x52, 0xdb, 0xcc, 0xb2, 0xec, 0xff, 0x34, 0x00, 0x3d, 0x9c, 0x9c, 0xa3,
-	0x61, 0xeb, 0x9c, 0x06, 0x67, 0x2a, 0xdb, 0x3e, 0x28, 0xc7, 0x2c, 0x64, 0xba, 0xd6, 0x98, 0xdf,
-	0xae, 0x35, 0x2d, 0x67, 0xf4, 0xb8, 0x0e, 0x1e, 0xc4, 0xce, 0x60, 0xcf, 0x39, 0x62, 0xe1, 0x41,
-	0x8e, 0xe1, 0x2c, 0xee, 0x5c, 0x7a, 0xc2, 0x18, 0x7e, 0x09, 0x96, 0xba, 0x79, 0x10, 0x9f, 0x64,
-	0x71, 0x17, 0xa7, 0xa2, 0xc0, 0x79, 0xaf, 0x26, 0xb0, 0x63, 0x01, 0xc1, 0x2f, 0x00, 0x28, 0x4c,
-	0xfa, 0x88, 0xf5, 0xf5, 0x79, 0xa1, 0x64, 0x51, 0x20, 0x87, 0x88, 0xf5, 0x61, 0x5b, 0xd1, 0x79,
-	0x77, 0xf5, 0x72, 0x43, 0xdb, 0xae, 0x35, 0x0d, 0xa7, 0x68, 0xbd, 0xa3, 0x5a, 0xef, 0x74, 0x54,
-	0xeb, 0x5b, 0xd5, 0xbb, 0xff, 0xad, 0xd2, 0xcd, 0x2b, 0x4b, 0x93, 0x41, 0x72, 0x66, 0xe2, 0x35,
-	0x4e, 0x41, 0xfd, 0x49, 0x6d, 0xf2, 0x25, 0x0e, 0xc0, 0x62, 0x2a, 0xcf, 0xaa, 0xc2, 0xad, 0x0f,
-	0x55, 0x28, 0xed, 0xbd, 0xb1, 0xa7, 0xbd, 0x0a, 0xe0, 0x2f, 0xf9, 0x74, 0xff, 0x8c, 0x52, 0x14,
-	0x33, 0xf9, 0x72, 0x76, 0x1b, 0xd4, 0x9f, 0xa0, 0x32, 0xe7, 0x0e, 0xa8, 0x24, 0x02, 0x11, 0xcf,
-	0x5f, 0x6b, 0x7e, 0xec, 0xc8, 0x69, 0x2c, 0xec, 0x5a, 0xe5, 0xbc, 0x12, 0x4f, 0xda, 0xd8, 0xfb,
-	0x60, 0xbd, 0x08, 0x92, 0x4b, 0x62, 0x2c, 0xdf, 0x03, 0xd5, 0x19, 0x1d, 0x7c, 0x84, 0x7a, 0xbd,
-	0x14, 0x33, 0x26, 0x1b, 0xa9, 0xae, 0xf6, 0x15, 0xd0, 0xa7, 0x9d, 0x64, 0xfa, 0xef, 0x80, 0x1e,
-	0x20, 0xe2, 0x07, 0x7d, 0x44, 0x42, 0xec, 0x73, 0x7a, 0x86, 0x89, 0x1f, 0xa3, 0x24, 0x89, 0x48,
-	0x28, 0xc2, 0x54, 0xbd, 0x4f, 0x03, 0x44, 0xda, 0x82, 0xee, 0xe4, 0xec, 0x51, 0x41, 0xc2, 0x4d,
-	0xb0, 0x92, 0x3b, 0xf2, 0x2c, 0x25, 0x7e, 0x37, 0x8d, 0x7a, 0x21, 0x16, 0x6d, 0xad, 0x7a, 0xcb,
-	0x01, 0x22, 0x9d, 0x2c, 0x25, 0x2d, 0x01, 0x36, 0xff, 0x2e, 0x83, 0x05, 0x91, 0x1d, 0xfe, 0xa6,
-	0x81, 0x95, 0x67, 0xfb, 0x01, 0x4d, 0x55, 0xed, 0xec, 0x95, 0x33, 0xac, 0x17, 0xf9, 0x42, 0xbf,
-	0xbd, 0xf3, 0xfb, 0x3f, 0x6f, 0xfe, 0x9a, 0xdb, 0x84, 0x5f, 0xcb, 0x25, 0xce, 0xf7, 0x5b, 0x8d,
-	0xbf, 0xdf, 0x1d, 0xfa, 0x62, 0x98, 0xdd, 0x2b, 0xf1, 0xb9, 0x86, 0x7f, 0x68, 0x60, 0xe5, 0xd9,
-	0x1a, 0x8c, 0x25, 0xcc, 0xde, 0xaf, 0xb1, 0x84, 0x17, 0xf6, 0xc7, 0x76, 0x85, 0x84, 0x6f, 0xe0,
-	0xd6, 0x84, 0x04, 0x91, 0x2f, 0xcf, 0xaf, 0xb4, 0xb8, 0x57, 0xea, 0x74, 0x0d, 0x0f, 0x41, 0x6d,
-	0x62, 0xfa, 0xa0, 0xa1, 0x12, 0x4c, 0xaf, 0x9b, 0xf1, 0xd9, 0x4c, 0x4e, 0x26, 0x2e, 0xc1, 0x53,
-	0x50, 0x29, 0xc6, 0x64, 0x1c, 0x64, 0x7a, 0xf2, 0xc6, 0x41, 0x66, 0xcc, 0x9f, 0xbd, 0x21, 0xd4,
-	0xd7, 0xe1, 0x27, 0x13, 0xea, 0x8b, 0x61, 0x83, 0x09, 0xa8, 0x4d, 0x8c, 0x0c, 0xb4, 0x9e, 0x86,
-	0x99, 0x9a, 0x40, 0xa3, 0xf1, 0xb2, 0x81, 0x4c, 0x66, 0x8a, 0x64, 0x3a, 0x5c, 0x9b, 0x4c, 0x36,
-	0xb6, 0x6b, 0x1d, 0xdf, 0x3d, 0x98, 0xda, 0xfd, 0x83, 0xa9, 0xbd, 0x7e, 0x30, 0xb5, 0x9b, 0x47,
-	0xb3, 0x74, 0xff, 0x68, 0x96, 0xfe, 0x7d, 0x34, 0x4b, 0x27, 0xdf, 0x86, 0x11, 0xef, 0x67, 0x5d,
-	0x27, 0xa0, 0xb1, 0x1b, 0xa4, 0xc3, 0x84, 0xd3, 0x5d, 0x9a, 0x86, 0xbb, 0x41, 0x1f, 0x45, 0x64,
-	0x14, 0xac, 0xe9, 0x5e, 0xaa, 0x33, 0x1f, 0x26, 0x98, 0x75, 0x2b, 0xe2, 0xd7, 0xb0, 0xff, 0x2e,
-	0x00, 0x00, 0xff, 0xff, 0x45, 0xdb, 0x55, 0xc7, 0x75, 0x06, 0x00, 0x00,
+func (m *QuerySimulateConversionRequest) Reset()         { *m = QuerySimulateConversionRequest{} }
+func (m *QuerySimulateConversionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateConversionRequest) ProtoMessage()    {}
+func (*QuerySimulateConversionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ed0fd688c48372, []int{13}
+}
+func (m *QuerySimulateConversionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateConversionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateConversionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateConversionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateConversionRequest.Merge(m, src)
+}
+func (m *QuerySimulateConversionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateConversionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateConversionRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QuerySimulateConversionRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ContractByDenom queries contract addresses by native denom
-	ContractByDenom(ctx context.Context, in *ContractByDenomRequest, opts ...grpc.CallOption) (*ContractByDenomResponse, error)
-	// DenomByContract queries native denom by contract address
-	DenomByContract(ctx context.Context, in *DenomByContractRequest, opts ...grpc.CallOption) (*DenomByContractResponse, error)
-	// ReplayBlock replay the eth messages in the block to recover the results of
-	// false-failed txs.
-	ReplayBlock(ctx context.Context, in *ReplayBlockRequest, opts ...grpc.CallOption) (*ReplayBlockResponse, error)
-	// Params queries all parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Params queries permissions for a specific address..
-	Permissions(ctx context.Context, in *QueryPermissionsRequest, opts ...grpc.CallOption) (*QueryPermissionsResponse, error)
+func (m *QuerySimulateConversionRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QuerySimulateConversionRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QuerySimulateConversionResponse is the response type for the Query/SimulateConversion RPC method.
+type QuerySimulateConversionResponse struct {
+	// the contract the coin is converted to, empty for the gas token; when auto_deploy is set,
+	// it's the address the contract would be deployed at in the current state.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// the amount of tokens received, scaled to the decimals of the contract or of the gas token.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// no contract is mapped to the denom yet, one will be deployed by the conversion.
+	AutoDeploy bool `protobuf:"varint,3,opt,name=auto_deploy,json=autoDeploy,proto3" json:"auto_deploy,omitempty"`
+	// the conversion fee charged on the coin, the amount is net of it.
+	Fee types1.Coin `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee"`
 }
 
-func (c *queryClient) ContractByDenom(ctx context.Context, in *ContractByDenomRequest, opts ...grpc.CallOption) (*ContractByDenomResponse, error) {
-	out := new(ContractByDenomResponse)
-	err := c.cc.Invoke(ctx, "/cronos.Query/ContractByDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QuerySimulateConversionResponse) Reset()         { *m = QuerySimulateConversionResponse{} }
+func (m *QuerySimulateConversionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateConversionResponse) ProtoMessage()    {}
+func (*QuerySimulateConversionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ed0fd688c48372, []int{14}
 }
-
-func (c *queryClient) DenomByContract(ctx context.Context, in *DenomByContractRequest, opts ...grpc.CallOption) (*DenomByContractResponse, error) {
-	out := new(DenomByContractResponse)
-	err := c.cc.Invoke(ctx, "/cronos.Query/DenomByContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateConversionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateConversionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateConversionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySimulateConversionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateConversionResponse.Merge(m, src)
+}
+func (m *QuerySimulateConversionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateConversionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateConversionResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ReplayBlock(ctx context.Context, in *ReplayBlockRequest, opts ...grpc.CallOption) (*ReplayBlockResponse, error) {
-	out := new(ReplayBlockResponse)
-	err := c.cc.Invoke(ctx, "/cronos.Query/ReplayBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateConversionResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateConversionResponse) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/cronos.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateConversionResponse) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Permissions(ctx context.Context, in *QueryPermissionsRequest, opts ...grpc.CallOption) (*QueryPermissionsResponse, error) {
-	out := new(QueryPermissionsResponse)
-	err := c.cc.Invoke(ctx, "/cronos.Query/Permissions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateConversionResponse) GetAutoDeploy() bool {
+	if m != nil {
+		return m.AutoDeploy
 	}
-	return out, nil
+	return false
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ContractByDenom queries contract addresses by native denom
-	ContractByDenom(context.Context, *ContractByDenomRequest) (*ContractByDenomResponse, error)
-	// DenomByContract queries native denom by contract address
-	DenomByContract(context.Context, *DenomByContractRequest) (*DenomByContractResponse, error)
-	// ReplayBlock replay the eth messages in the block to recover the results of
-	// false-failed txs.
-	ReplayBlock(context.Context, *ReplayBlockRequest) (*ReplayBlockResponse, error)
-	// Params queries all parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Params queries permissions for a specific address..
-	Permissions(context.Context, *QueryPermissionsRequest) (*QueryPermissionsResponse, error)
+func (m *QuerySimulateConversionResponse) GetFee() types1.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types1.Coin{}
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryEscrowBalancesRequest is the request type for the Query/EscrowBalances RPC method.
+type QueryEscrowBalancesRequest struct {
+	// pagination defines an optional pagination for the request, the key is the denom.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) ContractByDenom(ctx context.Context, req *ContractByDenomRequest) (*ContractByDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractByDenom not implemented")
-}
-func (*UnimplementedQueryServer) DenomByContract(ctx context.Context, req *DenomByContractRequest) (*DenomByContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomByContract not implemented")
+func (m *QueryEscrowBalancesRequest) Reset()         { *m = QueryEscrowBalancesRequest{} }
+func (m *QueryEscrowBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowBalancesRequest) ProtoMessage()    {}
+func (*QueryEscrowBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ed0fd688c48372, []int{15}
 }
-func (*UnimplementedQueryServer) ReplayBlock(ctx context.Context, req *ReplayBlockRequest) (*ReplayBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReplayBlock not implemented")
+func (m *QueryEscrowBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryEscrowBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowBalancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Permissions(ctx context.Context, req *QueryPermissionsRequest) (*QueryPermissionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Permissions not implemented")
+func (m *QueryEscrowBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowBalancesRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryEscrowBalancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowBalancesRequest.DiscardUnknown(m)
 }
 
-func _Query_ContractByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContractByDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractByDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cronos.Query/ContractByDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractByDenom(ctx, req.(*ContractByDenomRequest))
+var xxx_messageInfo_QueryEscrowBalancesRequest proto.InternalMessageInfo
+
+func (m *QueryEscrowBalancesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DenomByContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DenomByContractRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomByContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cronos.Query/DenomByContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomByContract(ctx, req.(*DenomByContractRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryEscrowBalancesResponse is the response type for the Query/EscrowBalances RPC method.
+type QueryEscrowBalancesResponse struct {
+	// the escrowed coins of the mapped denoms, the denoms with nothing escrowed are omitted.
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_ReplayBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReplayBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ReplayBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cronos.Query/ReplayBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ReplayBlock(ctx, req.(*ReplayBlockRequest))
+func (m *QueryEscrowBalancesResponse) Reset()         { *m = QueryEscrowBalancesResponse{} }
+func (m *QueryEscrowBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowBalancesResponse) ProtoMessage()    {}
+func (*QueryEscrowBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ed0fd688c48372, []int{16}
+}
+func (m *QueryEscrowBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowBalancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryEscrowBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowBalancesResponse.Merge(m, src)
+}
+func (m *QueryEscrowBalancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowBalancesResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cronos.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryEscrowBalancesResponse proto.InternalMessageInfo
+
+func (m *QueryEscrowBalancesResponse) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Permissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPermissionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Permissions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cronos.Query/Permissions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Permissions(ctx, req.(*QueryPermissionsRequest))
+func (m *QueryEscrowBalancesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cronos.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ContractByDenom",
-			Handler:    _Query_ContractByDenom_Handler,
-		},
-		{
-			MethodName: "DenomByContract",
-			Handler:    _Query_DenomByContract_Handler,
-		},
-		{
-			MethodName: "ReplayBlock",
-			Handler:    _Query_ReplayBlock_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Permissions",
-			Handler:    _Query_Permissions_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cronos/query.proto",
+// QueryDenomDeployInfoRequest is the request type for the Query/DenomDeployInfo RPC method.
+type QueryDenomDeployInfoRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *ContractByDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDenomDeployInfoRequest) Reset()         { *m = QueryDenomDeployInfoRequest{} }
+func (m *QueryDenomDeployInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomDeployInfoRequest) ProtoMessage()    {}
+func (*QueryDenomDeployInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ed0fd688c48372, []int{17}
+}
+func (m *QueryDenomDeployInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomDeployInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomDeployInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryDenomDeployInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomDeployInfoRequest.Merge(m, src)
+}
+func (m *QueryDenomDeployInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomDeployInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomDeployInfoRequest.DiscardUnknown(m)
 }
 
-func (m *ContractByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryDenomDeployInfoRequest proto.InternalMessageInfo
+
+func (m *QueryDenomDeployInfoRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *ContractByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+// QueryDenomDeployInfoResponse is the response type for the Query/DenomDeployInfo RPC method.
+type QueryDenomDeployInfoResponse struct {
+	// a contract is mapped to the denom
+	Found bool `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	// the contract mapped to the denom, empty if not found
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// the token is originated from cronos
+	IsSource bool `protobuf:"varint,3,opt,name=is_source,json=isSource,proto3" json:"is_source,omitempty"`
+	// the mapped contract is deployed automatically by the module
+	AutoDeployed bool `protobuf:"varint,4,opt,name=auto_deployed,json=autoDeployed,proto3" json:"auto_deployed,omitempty"`
+	// the auto-deployment is enabled by the params and allowed for the denom, a contract is deployed by the first
+	// conversion of the denom if it is not mapped yet
+	AutoDeploymentEnabled bool `protobuf:"varint,5,opt,name=auto_deployment_enabled,json=autoDeploymentEnabled,proto3" json:"auto_deployment_enabled,omitempty"`
+}
+
+func (m *QueryDenomDeployInfoResponse) Reset()         { *m = QueryDenomDeployInfoResponse{} }
+func (m *QueryDenomDeployInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomDeployInfoResponse) ProtoMessage()    {}
+func (*QueryDenomDeployInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ed0fd688c48372, []int{18}
+}
+func (m *QueryDenomDeployInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomDeployInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomDeployInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDenomDeployInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomDeployInfoResponse.Merge(m, src)
+}
+func (m *QueryDenomDeployInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomDeployInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomDeployInfoResponse.DiscardUnknown(m)
 }
 
-func (m *ContractByDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDenomDeployInfoResponse proto.InternalMessageInfo
+
+func (m *QueryDenomDeployInfoResponse) GetFound() bool {
+	if m != nil {
+		return m.Found
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *ContractByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDenomDeployInfoResponse) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
 }
 
-func (m *ContractByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AutoContract) > 0 {
-		i -= len(m.AutoContract)
-		copy(dAtA[i:], m.AutoContract)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AutoContract)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryDenomDeployInfoResponse) GetIsSource() bool {
+	if m != nil {
+		return m.IsSource
 	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *QueryDenomDeployInfoResponse) GetAutoDeployed() bool {
+	if m != nil {
+		return m.AutoDeployed
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *DenomByContractRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDenomDeployInfoResponse) GetAutoDeploymentEnabled() bool {
+	if m != nil {
+		return m.AutoDeploymentEnabled
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *DenomByContractRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryContractsByDenomsRequest is the request type for the Query/ContractsByDenoms RPC method.
+type QueryContractsByDenomsRequest struct {
+	// the denoms to resolve, at most MaxContractsByDenomsQuery of them
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
 }
 
-func (m *DenomByContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryContractsByDenomsRequest) Reset()         { *m = QueryContractsByDenomsRequest{} }
+func (m *QueryContractsByDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractsByDenomsRequest) ProtoMessage()    {}
+func (*QueryContractsByDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ed0fd688c48372, []int{19}
 }
-
-func (m *DenomByContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryContractsByDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractsByDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractsByDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *DenomByContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryContractsByDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractsByDenomsRequest.Merge(m, src)
 }
-
-func (m *DenomByContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryContractsByDenomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractsByDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractsByDenomsRequest.DiscardUnknown(m)
 }
 
-func (m *ReplayBlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryContractsByDenomsRequest proto.InternalMessageInfo
+
+func (m *QueryContractsByDenomsRequest) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ReplayBlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryContractsByDenomsResponse is the response type for the Query/ContractsByDenoms RPC method.
+type QueryContractsByDenomsResponse struct {
+	// the results in the order of the requested denoms
+	Contracts []DenomContract `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts"`
 }
 
-func (m *ReplayBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintQuery(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x22
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.BlockNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Msgs) > 0 {
-		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryContractsByDenomsResponse) Reset()         { *m = QueryContractsByDenomsResponse{} }
+func (m *QueryContractsByDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractsByDenomsResponse) ProtoMessage()    {}
+func (*QueryContractsByDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ed0fd688c48372, []int{20}
+}
+func (m *QueryContractsByDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractsByDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractsByDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryContractsByDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractsByDenomsResponse.Merge(m, src)
+}
+func (m *QueryContractsByDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractsByDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractsByDenomsResponse.DiscardUnknown(m)
 }
 
-func (m *ReplayBlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryContractsByDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryContractsByDenomsResponse) GetContracts() []DenomContract {
+	if m != nil {
+		return m.Contracts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ReplayBlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DenomContract is the contract resolved for a native denom
+type DenomContract struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// a contract is mapped to the denom
+	Found bool `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	// the contract mapped to the denom, the external contract is taken in preference to the auto-deployed one,
+	// empty if not found
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
 }
 
-func (m *ReplayBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Responses) > 0 {
-		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *DenomContract) Reset()         { *m = DenomContract{} }
+func (m *DenomContract) String() string { return proto.CompactTextString(m) }
+func (*DenomContract) ProtoMessage()    {}
+func (*DenomContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ed0fd688c48372, []int{21}
+}
+func (m *DenomContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *DenomContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomContract.Merge(m, src)
+}
+func (m *DenomContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomContract.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_DenomContract proto.InternalMessageInfo
+
+func (m *DenomContract) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *DenomContract) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DenomContract) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCRC20BalanceRequest is the request type for the Query/CRC20Balance RPC method.
+type QueryCRC20BalanceRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the hex evm address of the holder
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryCRC20BalanceRequest) Reset()         { *m = QueryCRC20BalanceRequest{} }
+func (m *QueryCRC20BalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCRC20BalanceRequest) ProtoMessage()    {}
+func (*QueryCRC20BalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ed0fd688c48372, []int{22}
+}
+func (m *QueryCRC20BalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCRC20BalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCRC20BalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryCRC20BalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCRC20BalanceRequest.Merge(m, src)
+}
+func (m *QueryCRC20BalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCRC20BalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCRC20BalanceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPermissionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCRC20BalanceRequest proto.InternalMessageInfo
+
+func (m *QueryCRC20BalanceRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPermissionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCRC20BalanceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *QueryPermissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryCRC20BalanceResponse is the response type for the Query/CRC20Balance RPC method.
+type QueryCRC20BalanceResponse struct {
+	// the contract mapped to the denom
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// the balance in the smallest unit of the contract
+	Balance string `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
 }
 
-func (m *QueryPermissionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCRC20BalanceResponse) Reset()         { *m = QueryCRC20BalanceResponse{} }
+func (m *QueryCRC20BalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCRC20BalanceResponse) ProtoMessage()    {}
+func (*QueryCRC20BalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ed0fd688c48372, []int{23}
+}
+func (m *QueryCRC20BalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCRC20BalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCRC20BalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryCRC20BalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCRC20BalanceResponse.Merge(m, src)
+}
+func (m *QueryCRC20BalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCRC20BalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCRC20BalanceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryCRC20BalanceResponse proto.InternalMessageInfo
+
+func (m *QueryCRC20BalanceResponse) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
 }
 
-func (m *QueryPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CanTurnBridge {
-		i--
-		if m.CanTurnBridge {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+func (m *QueryCRC20BalanceResponse) GetBalance() string {
+	if m != nil {
+		return m.Balance
 	}
-	if m.CanChangeTokenMapping {
-		i--
-		if m.CanChangeTokenMapping {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return ""
+}
+
+// QueryCRC20SupplyRequest is the request type for the Query/CRC20Supply RPC method.
+type QueryCRC20SupplyRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryCRC20SupplyRequest) Reset()         { *m = QueryCRC20SupplyRequest{} }
+func (m *QueryCRC20SupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCRC20SupplyRequest) ProtoMessage()    {}
+func (*QueryCRC20SupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ed0fd688c48372, []int{24}
+}
+func (m *QueryCRC20SupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCRC20SupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCRC20SupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCRC20SupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCRC20SupplyRequest.Merge(m, src)
+}
+func (m *QueryCRC20SupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCRC20SupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCRC20SupplyRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryCRC20SupplyRequest proto.InternalMessageInfo
+
+func (m *QueryCRC20SupplyRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *ContractByDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+// QueryCRC20SupplyResponse is the response type for the Query/CRC20Supply RPC method.
+type QueryCRC20SupplyResponse struct {
+	// the contract mapped to the denom
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// the total supply in the smallest unit of the contract
+	TotalSupply string `protobuf:"bytes,2,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
 }
 
-func (m *ContractByDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AutoContract)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryCRC20SupplyResponse) Reset()         { *m = QueryCRC20SupplyResponse{} }
+func (m *QueryCRC20SupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCRC20SupplyResponse) ProtoMessage()    {}
+func (*QueryCRC20SupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ed0fd688c48372, []int{25}
+}
+func (m *QueryCRC20SupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCRC20SupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCRC20SupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryCRC20SupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCRC20SupplyResponse.Merge(m, src)
+}
+func (m *QueryCRC20SupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCRC20SupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCRC20SupplyResponse.DiscardUnknown(m)
 }
 
-func (m *DenomByContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryCRC20SupplyResponse proto.InternalMessageInfo
+
+func (m *QueryCRC20SupplyResponse) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	return n
+	return ""
 }
 
-func (m *DenomByContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryCRC20SupplyResponse) GetTotalSupply() string {
+	if m != nil {
+		return m.TotalSupply
 	}
-	return n
+	return ""
 }
 
-func (m *ReplayBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Msgs) > 0 {
-		for _, e := range m.Msgs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+// QueryConversionHistoryRequest is the request type for the Query/ConversionHistory RPC method.
+type QueryConversionHistoryRequest struct {
+	// the hex evm address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the maximum number of conversions returned, all the recorded ones if zero
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryConversionHistoryRequest) Reset()         { *m = QueryConversionHistoryRequest{} }
+func (m *QueryConversionHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionHistoryRequest) ProtoMessage()    {}
+func (*QueryConversionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ed0fd688c48372, []int{26}
+}
+func (m *QueryConversionHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.BlockNumber != 0 {
-		n += 1 + sovQuery(uint64(m.BlockNumber))
-	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryConversionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionHistoryRequest.Merge(m, src)
+}
+func (m *QueryConversionHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConversionHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryConversionHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *ReplayBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryConversionHistoryRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	var l int
-	_ = l
-	if len(m.Responses) > 0 {
-		for _, e := range m.Responses {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+// QueryConversionHistoryResponse is the response type for the Query/ConversionHistory RPC method.
+type QueryConversionHistoryResponse struct {
+	// the recent conversions, the latest first, it's empty if the history is disabled
+	Records []ConversionRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *QueryConversionHistoryResponse) Reset()         { *m = QueryConversionHistoryResponse{} }
+func (m *QueryConversionHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionHistoryResponse) ProtoMessage()    {}
+func (*QueryConversionHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ed0fd688c48372, []int{27}
+}
+func (m *QueryConversionHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryConversionHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionHistoryResponse.Merge(m, src)
+}
+func (m *QueryConversionHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryConversionHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryConversionHistoryResponse) GetRecords() []ConversionRecord {
+	if m != nil {
+		return m.Records
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func init() {
+	proto.RegisterType((*ContractByDenomRequest)(nil), "cronos.ContractByDenomRequest")
+	proto.RegisterType((*ContractByDenomResponse)(nil), "cronos.ContractByDenomResponse")
+	proto.RegisterType((*DenomByContractRequest)(nil), "cronos.DenomByContractRequest")
+	proto.RegisterType((*DenomByContractResponse)(nil), "cronos.DenomByContractResponse")
+	proto.RegisterType((*QueryTokenMappingsRequest)(nil), "cronos.QueryTokenMappingsRequest")
+	proto.RegisterType((*QueryTokenMappingsResponse)(nil), "cronos.QueryTokenMappingsResponse")
+	proto.RegisterType((*TokenMappingInfo)(nil), "cronos.TokenMappingInfo")
+	proto.RegisterType((*ReplayBlockRequest)(nil), "cronos.ReplayBlockRequest")
+	proto.RegisterType((*ReplayBlockResponse)(nil), "cronos.ReplayBlockResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "cronos.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cronos.QueryParamsResponse")
+	proto.RegisterType((*QueryPermissionsRequest)(nil), "cronos.QueryPermissionsRequest")
+	proto.RegisterType((*QueryPermissionsResponse)(nil), "cronos.QueryPermissionsResponse")
+	proto.RegisterType((*QuerySimulateConversionRequest)(nil), "cronos.QuerySimulateConversionRequest")
+	proto.RegisterType((*QuerySimulateConversionResponse)(nil), "cronos.QuerySimulateConversionResponse")
+	proto.RegisterType((*QueryEscrowBalancesRequest)(nil), "cronos.QueryEscrowBalancesRequest")
+	proto.RegisterType((*QueryEscrowBalancesResponse)(nil), "cronos.QueryEscrowBalancesResponse")
+	proto.RegisterType((*QueryDenomDeployInfoRequest)(nil), "cronos.QueryDenomDeployInfoRequest")
+	proto.RegisterType((*QueryDenomDeployInfoResponse)(nil), "cronos.QueryDenomDeployInfoResponse")
+	proto.RegisterType((*QueryContractsByDenomsRequest)(nil), "cronos.QueryContractsByDenomsRequest")
+	proto.RegisterType((*QueryContractsByDenomsResponse)(nil), "cronos.QueryContractsByDenomsResponse")
+	proto.RegisterType((*DenomContract)(nil), "cronos.DenomContract")
+	proto.RegisterType((*QueryCRC20BalanceRequest)(nil), "cronos.QueryCRC20BalanceRequest")
+	proto.RegisterType((*QueryCRC20BalanceResponse)(nil), "cronos.QueryCRC20BalanceResponse")
+	proto.RegisterType((*QueryCRC20SupplyRequest)(nil), "cronos.QueryCRC20SupplyRequest")
+	proto.RegisterType((*QueryCRC20SupplyResponse)(nil), "cronos.QueryCRC20SupplyResponse")
+	proto.RegisterType((*QueryConversionHistoryRequest)(nil), "cronos.QueryConversionHistoryRequest")
+	proto.RegisterType((*QueryConversionHistoryResponse)(nil), "cronos.QueryConversionHistoryResponse")
 }
 
-func (m *QueryPermissionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func init() { proto.RegisterFile("cronos/query.proto", fileDescriptor_d4ed0fd688c48372) }
+
+var fileDescriptor_d4ed0fd688c48372 = []byte{
+	// 1572 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x5f, 0x6f, 0x13, 0xc7,
+	0x16, 0xcf, 0x26, 0x10, 0x9c, 0xe3, 0x04, 0x2e, 0x13, 0x48, 0x9c, 0x4d, 0xb0, 0x93, 0x85, 0x9b,
+	0xe4, 0x5e, 0x81, 0x97, 0x24, 0x55, 0xa1, 0x3c, 0xf4, 0xc1, 0x26, 0x2d, 0xad, 0x04, 0x05, 0x13,
+	0xa9, 0x2a, 0x45, 0xb2, 0xd6, 0xeb, 0x89, 0xbd, 0xc2, 0xbb, 0xb3, 0xec, 0xec, 0xa6, 0x58, 0x51,
+	0xa4, 0x8a, 0x4a, 0x55, 0x55, 0xa9, 0x12, 0x12, 0x5f, 0x80, 0x3e, 0xb6, 0x1f, 0xa4, 0x42, 0x7d,
+	0x29, 0x52, 0x5f, 0xaa, 0x3e, 0x94, 0x0a, 0xfa, 0xd0, 0x8f, 0x51, 0xed, 0xcc, 0x99, 0xf5, 0x6e,
+	0xfc, 0x27, 0x3c, 0xd0, 0x27, 0xef, 0xcc, 0xfc, 0xe6, 0x9c, 0xdf, 0xcc, 0xf9, 0xcd, 0x39, 0xc7,
+	0x40, 0xec, 0x80, 0x79, 0x8c, 0x9b, 0x0f, 0x23, 0x1a, 0x74, 0xcb, 0x7e, 0xc0, 0x42, 0x46, 0x26,
+	0xe5, 0x9c, 0x7e, 0xa6, 0xc5, 0x5a, 0x4c, 0x4c, 0x99, 0xf1, 0x97, 0x5c, 0xd5, 0x97, 0x5a, 0x8c,
+	0xb5, 0x3a, 0xd4, 0xb4, 0x7c, 0xc7, 0xb4, 0x3c, 0x8f, 0x85, 0x56, 0xe8, 0x30, 0x8f, 0xe3, 0x6a,
+	0x09, 0x57, 0xc5, 0xa8, 0x11, 0xed, 0x9a, 0xa1, 0xe3, 0x52, 0x1e, 0x5a, 0xae, 0x8f, 0x80, 0xff,
+	0xdb, 0x8c, 0xbb, 0x8c, 0x9b, 0x0d, 0x8b, 0x53, 0xe9, 0xd5, 0xdc, 0xdb, 0x68, 0xd0, 0xd0, 0xda,
+	0x30, 0x7d, 0xab, 0xe5, 0x78, 0xc2, 0x1a, 0x62, 0x8b, 0x69, 0xac, 0x42, 0xd9, 0xcc, 0x51, 0xeb,
+	0x0b, 0x34, 0x6c, 0xd3, 0xc0, 0x75, 0xbc, 0xd0, 0xa4, 0x7b, 0xae, 0xb9, 0xb7, 0x61, 0x86, 0x8f,
+	0x70, 0x69, 0x16, 0xcf, 0x25, 0x7f, 0xe4, 0xa4, 0x71, 0x15, 0xe6, 0xaa, 0xcc, 0x0b, 0x03, 0xcb,
+	0x0e, 0x2b, 0xdd, 0xeb, 0xd4, 0x63, 0x6e, 0x8d, 0x3e, 0x8c, 0x28, 0x0f, 0xc9, 0x19, 0x38, 0xde,
+	0x8c, 0xc7, 0x05, 0x6d, 0x59, 0x5b, 0x9f, 0xaa, 0xc9, 0xc1, 0xb5, 0xdc, 0x37, 0xcf, 0x4a, 0x63,
+	0x7f, 0x3f, 0x2b, 0x8d, 0x19, 0xf7, 0x60, 0xbe, 0x6f, 0x27, 0xf7, 0x99, 0xc7, 0x29, 0xd1, 0x21,
+	0x67, 0xe3, 0x12, 0xee, 0x4e, 0xc6, 0xe4, 0x3c, 0xcc, 0x58, 0x51, 0xc8, 0xea, 0x09, 0x60, 0x5c,
+	0x00, 0xa6, 0xe3, 0x49, 0x65, 0xcf, 0x78, 0x1f, 0xe6, 0x84, 0xc5, 0x4a, 0x57, 0x4d, 0x29, 0x56,
+	0x23, 0x4c, 0xa7, 0xb8, 0x99, 0x30, 0xdf, 0xb7, 0x1f, 0xb9, 0x0d, 0x3c, 0x96, 0x61, 0xc3, 0xc2,
+	0x9d, 0xf8, 0xe2, 0x77, 0xd8, 0x03, 0xea, 0xdd, 0xb4, 0x7c, 0xdf, 0xf1, 0x5a, 0x5c, 0xf9, 0xfc,
+	0x00, 0xa0, 0x17, 0x07, 0xb1, 0x2f, 0xbf, 0xb9, 0x5a, 0x96, 0x81, 0x28, 0xc7, 0x81, 0x28, 0x4b,
+	0xa9, 0x60, 0x38, 0xca, 0xb7, 0xad, 0x16, 0xc5, 0xbd, 0xb5, 0xd4, 0x4e, 0xe3, 0x7b, 0x0d, 0xf4,
+	0x41, 0x5e, 0x90, 0xd9, 0x35, 0xc8, 0xb9, 0x38, 0x57, 0xd0, 0x96, 0x27, 0xd6, 0xf3, 0x9b, 0x85,
+	0x32, 0xc6, 0x2a, 0xbd, 0xe1, 0x23, 0x6f, 0x97, 0x55, 0x8e, 0x3d, 0xff, 0xa3, 0x34, 0x56, 0x4b,
+	0xf0, 0xe4, 0xc3, 0x0c, 0xc5, 0x71, 0x41, 0x71, 0xed, 0x48, 0x8a, 0xd2, 0x71, 0x86, 0xe3, 0xd7,
+	0x1a, 0xfc, 0xe7, 0xb0, 0xb7, 0xc1, 0x77, 0x96, 0x09, 0xc5, 0xf8, 0xa1, 0x28, 0x2f, 0xc2, 0x94,
+	0xc3, 0xeb, 0x9c, 0x45, 0x81, 0x4d, 0x0b, 0x13, 0xcb, 0xda, 0x7a, 0xae, 0x96, 0x73, 0xf8, 0x5d,
+	0x31, 0x4e, 0x24, 0xd0, 0xa4, 0x7e, 0x87, 0x75, 0x69, 0xb3, 0x70, 0x4c, 0x00, 0x84, 0x04, 0xae,
+	0xe3, 0x9c, 0xf1, 0xbb, 0x06, 0xa4, 0x46, 0xfd, 0x8e, 0xd5, 0xad, 0x74, 0x98, 0xfd, 0x40, 0xc5,
+	0x62, 0x0b, 0x8e, 0xb9, 0x3c, 0xb9, 0xa0, 0x52, 0x39, 0x91, 0x7b, 0x99, 0xee, 0xb9, 0xe5, 0xbd,
+	0x8d, 0xf2, 0x4d, 0xde, 0xda, 0x8e, 0xe7, 0x68, 0xe4, 0xee, 0x3c, 0xaa, 0x09, 0x30, 0x59, 0x81,
+	0xe9, 0x46, 0x6c, 0xa4, 0xee, 0x45, 0x6e, 0x83, 0x06, 0x82, 0xed, 0x44, 0x2d, 0x2f, 0xe6, 0x6e,
+	0x89, 0x29, 0x72, 0x0e, 0x40, 0x42, 0xda, 0x16, 0x6f, 0x0b, 0xc6, 0x53, 0xb5, 0x29, 0x31, 0x73,
+	0xc3, 0xe2, 0x6d, 0x52, 0x55, 0xcb, 0xf1, 0xdb, 0x15, 0x7c, 0xf3, 0x9b, 0x7a, 0x59, 0x3e, 0xec,
+	0xb2, 0x7a, 0xd8, 0xe5, 0x1d, 0xf5, 0xb0, 0x2b, 0xb9, 0x38, 0x3e, 0x4f, 0x5e, 0x96, 0x34, 0x34,
+	0x12, 0xaf, 0xa4, 0xf4, 0x79, 0x1f, 0x66, 0x33, 0x67, 0x43, 0x05, 0x6c, 0xc3, 0x54, 0x80, 0xdf,
+	0xea, 0x84, 0x6b, 0x47, 0x9d, 0x50, 0x05, 0xb1, 0xb7, 0xd3, 0x38, 0x03, 0x44, 0xc8, 0xec, 0xb6,
+	0x15, 0x58, 0xae, 0x52, 0xb1, 0x51, 0x85, 0xd9, 0xcc, 0x2c, 0xfa, 0xbc, 0x08, 0x93, 0xbe, 0x98,
+	0x41, 0x61, 0x9f, 0x54, 0x9a, 0x93, 0x38, 0x54, 0x1a, 0x62, 0x8c, 0x2d, 0x98, 0x97, 0x46, 0x62,
+	0x4a, 0x9c, 0xc7, 0x59, 0x4e, 0x45, 0xa6, 0x00, 0x27, 0xac, 0x66, 0x33, 0xa0, 0x9c, 0xa3, 0x4c,
+	0xd4, 0xd0, 0xd8, 0x87, 0x42, 0xff, 0x26, 0x74, 0x7f, 0x05, 0x0a, 0xb6, 0xe5, 0xd5, 0xed, 0xb6,
+	0xe5, 0xb5, 0x68, 0x3d, 0x8c, 0x95, 0x57, 0x47, 0x55, 0x0b, 0x33, 0xb9, 0xda, 0x59, 0xdb, 0xf2,
+	0xaa, 0x62, 0x39, 0xad, 0x4b, 0xb2, 0x0a, 0xa7, 0xe2, 0x8d, 0x61, 0x14, 0x78, 0xf5, 0x46, 0xe0,
+	0x34, 0x5b, 0x54, 0x84, 0x35, 0x57, 0x9b, 0xb1, 0x2d, 0x6f, 0x27, 0x0a, 0xbc, 0x8a, 0x98, 0x34,
+	0x6e, 0x41, 0x51, 0x38, 0xbf, 0xeb, 0xb8, 0x51, 0xc7, 0x0a, 0x69, 0x95, 0x79, 0x7b, 0x34, 0x88,
+	0x49, 0x8c, 0x4c, 0x74, 0x64, 0x0e, 0x26, 0x2d, 0x97, 0x45, 0x9e, 0xd2, 0x36, 0x8e, 0x8c, 0x1f,
+	0x34, 0x28, 0x0d, 0x35, 0xf8, 0x06, 0xf9, 0x6f, 0x88, 0x5d, 0x52, 0x82, 0x7c, 0xea, 0x51, 0xe0,
+	0x9b, 0x81, 0xde, 0x93, 0x20, 0x1b, 0x30, 0xb1, 0x4b, 0x95, 0xf6, 0x16, 0x32, 0x6f, 0x5b, 0xbd,
+	0xea, 0x2a, 0x73, 0x3c, 0x0c, 0x58, 0x8c, 0x35, 0x9a, 0x98, 0x6f, 0xb6, 0xb9, 0x1d, 0xb0, 0x2f,
+	0x2a, 0x56, 0xc7, 0xf2, 0x6c, 0xfa, 0xd6, 0xd3, 0xda, 0x2f, 0x1a, 0x2c, 0x0e, 0x74, 0x83, 0xb7,
+	0xd1, 0x82, 0x5c, 0x03, 0xe7, 0x50, 0xd4, 0x23, 0xd8, 0x5f, 0x8e, 0xd9, 0xff, 0xf8, 0xb2, 0xb4,
+	0xde, 0x72, 0xc2, 0x76, 0xd4, 0x28, 0xdb, 0xcc, 0x35, 0xb1, 0xe4, 0xc9, 0x9f, 0x4b, 0xbc, 0xf9,
+	0xc0, 0x0c, 0xbb, 0x3e, 0xe5, 0x62, 0x03, 0xaf, 0x25, 0xc6, 0xdf, 0x5e, 0x12, 0xdc, 0xc2, 0x03,
+	0x89, 0x1a, 0x22, 0xaf, 0x3f, 0xce, 0x83, 0x23, 0x05, 0x63, 0xfc, 0xac, 0xc1, 0xd2, 0xe0, 0x5d,
+	0xbd, 0xca, 0xb3, 0xcb, 0x22, 0xaf, 0x89, 0xba, 0x96, 0x83, 0x7f, 0x37, 0x8b, 0x92, 0x77, 0x61,
+	0x3e, 0x05, 0x72, 0xa9, 0x17, 0xd6, 0xa9, 0x67, 0x35, 0x3a, 0xb4, 0x59, 0x38, 0x2e, 0x5f, 0x57,
+	0x0f, 0x1e, 0xaf, 0x6e, 0xcb, 0x45, 0xe3, 0x0a, 0x9c, 0x13, 0x67, 0x51, 0xe5, 0x93, 0x63, 0x89,
+	0x4f, 0xc4, 0x33, 0x07, 0x93, 0xe2, 0xd8, 0x32, 0xa4, 0x53, 0x35, 0x1c, 0x19, 0x9f, 0x43, 0x71,
+	0xd8, 0x46, 0xbc, 0x86, 0xf7, 0x60, 0x4a, 0x1d, 0x50, 0xe9, 0xe1, 0xac, 0xca, 0x39, 0x02, 0x9a,
+	0x74, 0x15, 0x52, 0xc9, 0x3d, 0xb4, 0xf1, 0x29, 0xcc, 0x64, 0x10, 0x43, 0x9e, 0x6e, 0x72, 0xd1,
+	0xe3, 0xc3, 0x2e, 0x7a, 0x22, 0x7b, 0xd1, 0xc6, 0xc7, 0x98, 0xa1, 0xaa, 0xb5, 0xea, 0xe6, 0x65,
+	0x14, 0xf0, 0xe8, 0xf4, 0x90, 0xca, 0x76, 0xe3, 0xd9, 0x6c, 0x77, 0x07, 0x16, 0x06, 0xd8, 0x7a,
+	0x83, 0xcc, 0x50, 0x80, 0x13, 0x28, 0x65, 0x65, 0x12, 0x87, 0x71, 0x3b, 0xd3, 0x33, 0x79, 0x37,
+	0xf2, 0xfd, 0x4e, 0x77, 0xb4, 0x16, 0x3f, 0x83, 0x42, 0xff, 0x86, 0x37, 0xa0, 0xb0, 0x02, 0xd3,
+	0x21, 0x0b, 0xad, 0x4e, 0x9d, 0x8b, 0x3d, 0xc8, 0x23, 0x2f, 0xe6, 0xa4, 0x19, 0xe3, 0x93, 0x9e,
+	0x32, 0x30, 0xed, 0xdd, 0x70, 0x78, 0xc8, 0x82, 0xee, 0x91, 0x75, 0x20, 0xe6, 0xda, 0x71, 0x5c,
+	0x47, 0xea, 0x7c, 0xa6, 0x26, 0x07, 0xc6, 0x3d, 0x28, 0x0e, 0x33, 0x88, 0x8c, 0xaf, 0xc2, 0x89,
+	0x80, 0xda, 0x2c, 0x68, 0xf6, 0xf5, 0x45, 0xe9, 0xdc, 0x1b, 0x03, 0x50, 0x32, 0x0a, 0xbe, 0xf9,
+	0xd3, 0x34, 0x1c, 0x17, 0xc6, 0xc9, 0x97, 0x1a, 0x9c, 0x3a, 0xd4, 0xae, 0x92, 0x62, 0xca, 0xcc,
+	0x80, 0x0e, 0x58, 0x2f, 0x0d, 0x5d, 0x97, 0xc4, 0x8c, 0x8b, 0x8f, 0x7f, 0xfd, 0xeb, 0xe9, 0xf8,
+	0x2a, 0xb9, 0x80, 0x3d, 0x75, 0xdc, 0x6e, 0xab, 0xbb, 0xac, 0x37, 0xba, 0x75, 0x11, 0x0c, 0x73,
+	0x5f, 0xfc, 0x1c, 0x90, 0xaf, 0x34, 0x38, 0x75, 0xa8, 0x2b, 0xed, 0x51, 0x18, 0xdc, 0xee, 0xea,
+	0xa5, 0xa1, 0xeb, 0x48, 0xc1, 0x14, 0x14, 0xfe, 0x47, 0xd6, 0x52, 0x14, 0x84, 0xbf, 0xd8, 0xbf,
+	0xe2, 0x62, 0xee, 0xab, 0xaf, 0x03, 0xd2, 0x85, 0x99, 0x4c, 0xfb, 0x49, 0x56, 0x94, 0x8b, 0xa1,
+	0x0d, 0xb0, 0x6e, 0x8c, 0x82, 0x20, 0x91, 0x15, 0x41, 0x64, 0x91, 0x2c, 0xa4, 0x88, 0x64, 0xca,
+	0x39, 0x27, 0x37, 0x20, 0x9f, 0xea, 0x7a, 0x88, 0xae, 0xac, 0xf6, 0xb7, 0x79, 0xfa, 0xe2, 0xc0,
+	0x35, 0x74, 0x35, 0x46, 0xee, 0xc3, 0xa4, 0x6c, 0x4f, 0x88, 0x9e, 0xa1, 0x96, 0xe9, 0x78, 0xf4,
+	0xc5, 0x81, 0x6b, 0x68, 0x64, 0x41, 0xf0, 0x9d, 0x25, 0xa7, 0x53, 0x7c, 0x65, 0x93, 0x43, 0x7c,
+	0xc8, 0xa7, 0x5a, 0x15, 0x52, 0xca, 0x9a, 0xe9, 0xeb, 0x7c, 0xf4, 0xe5, 0xe1, 0x00, 0x74, 0x56,
+	0x14, 0xce, 0x0a, 0x64, 0x2e, 0xed, 0x2c, 0xe5, 0xe2, 0x3b, 0x0d, 0x48, 0x7f, 0x3f, 0x41, 0x56,
+	0x33, 0x86, 0x87, 0x76, 0x30, 0xfa, 0xda, 0x91, 0x38, 0xe4, 0xb1, 0x2a, 0x78, 0x2c, 0x93, 0x62,
+	0x8a, 0x07, 0x47, 0x78, 0xdd, 0x4e, 0xf0, 0xe4, 0x00, 0x4e, 0x66, 0x8b, 0x39, 0xc9, 0x4a, 0x60,
+	0x60, 0x43, 0xa1, 0x9f, 0x1f, 0x89, 0x41, 0x0a, 0x86, 0xa0, 0xb0, 0x44, 0xf4, 0x14, 0x05, 0x2a,
+	0xa0, 0xf5, 0xa4, 0x90, 0x3f, 0x56, 0x2f, 0xa5, 0x57, 0x45, 0x49, 0xd6, 0xf8, 0xe0, 0xca, 0xac,
+	0x5f, 0x18, 0x0d, 0x42, 0x0a, 0x17, 0x04, 0x85, 0x22, 0x59, 0xea, 0x7b, 0x33, 0xb2, 0x4c, 0xd6,
+	0x9d, 0xd8, 0xe1, 0xb7, 0x1a, 0x9c, 0xee, 0xab, 0x62, 0xe4, 0xbf, 0x19, 0x0f, 0xc3, 0xca, 0xa3,
+	0xbe, 0x7a, 0x14, 0x6c, 0x44, 0x40, 0x92, 0x7a, 0x97, 0xa4, 0x10, 0x4e, 0x22, 0x98, 0x4e, 0xd7,
+	0x13, 0x92, 0x95, 0xdc, 0x80, 0xb2, 0xa5, 0xaf, 0x8c, 0x40, 0xa0, 0xf3, 0x65, 0xe1, 0x5c, 0x27,
+	0x85, 0xb4, 0xf3, 0xc0, 0xde, 0xbc, 0xac, 0x22, 0x41, 0x1e, 0x42, 0x3e, 0x55, 0x42, 0x0e, 0xbd,
+	0x84, 0xfe, 0x6a, 0xa4, 0x2f, 0x0f, 0x07, 0xa0, 0xcf, 0x92, 0xf0, 0xb9, 0x40, 0xe6, 0xfb, 0x7c,
+	0xca, 0x92, 0x43, 0x9e, 0xca, 0x6b, 0xcf, 0x96, 0x82, 0xfe, 0x6b, 0x1f, 0x58, 0x7b, 0xf4, 0xd5,
+	0xa3, 0x60, 0x23, 0xb2, 0x66, 0x4f, 0xfe, 0xf5, 0xb6, 0x84, 0x9b, 0xfb, 0x58, 0xb9, 0x0e, 0x2a,
+	0xb7, 0x9e, 0xbf, 0x2a, 0x6a, 0x2f, 0x5e, 0x15, 0xb5, 0x3f, 0x5f, 0x15, 0xb5, 0x27, 0xaf, 0x8b,
+	0x63, 0x2f, 0x5e, 0x17, 0xc7, 0x7e, 0x7b, 0x5d, 0x1c, 0xbb, 0xf7, 0x4e, 0xba, 0x51, 0x0d, 0xba,
+	0x7e, 0xc8, 0x2e, 0xb1, 0xa0, 0x75, 0xc9, 0x6e, 0x5b, 0x8e, 0x97, 0x58, 0xdf, 0x34, 0x1f, 0xa9,
+	0x6f, 0xd1, 0xba, 0x36, 0x26, 0xc5, 0x7f, 0xc6, 0xad, 0x7f, 0x06, 0x00, 0x85, 0x14, 0xd2, 0xa4,
+	0x6c, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ContractByDenom queries contract addresses by native denom
+	ContractByDenom(ctx context.Context, in *ContractByDenomRequest, opts ...grpc.CallOption) (*ContractByDenomResponse, error)
+	// DenomByContract queries native denom by contract address
+	DenomByContract(ctx context.Context, in *DenomByContractRequest, opts ...grpc.CallOption) (*DenomByContractResponse, error)
+	// TokenMappings queries all the token mappings, ordered by denom
+	TokenMappings(ctx context.Context, in *QueryTokenMappingsRequest, opts ...grpc.CallOption) (*QueryTokenMappingsResponse, error)
+	// ReplayBlock replay the eth messages in the block to recover the results of
+	// false-failed txs.
+	ReplayBlock(ctx context.Context, in *ReplayBlockRequest, opts ...grpc.CallOption) (*ReplayBlockResponse, error)
+	// Params queries all parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Params queries permissions for a specific address..
+	Permissions(ctx context.Context, in *QueryPermissionsRequest, opts ...grpc.CallOption) (*QueryPermissionsResponse, error)
+	// SimulateConversion previews the result of converting a native coin with MsgConvertVouchers,
+	// without any state change.
+	SimulateConversion(ctx context.Context, in *QuerySimulateConversionRequest, opts ...grpc.CallOption) (*QuerySimulateConversionResponse, error)
+	// EscrowBalances queries the coins escrowed by the module to back the outstanding crc20 tokens,
+	// ordered by denom.
+	EscrowBalances(ctx context.Context, in *QueryEscrowBalancesRequest, opts ...grpc.CallOption) (*QueryEscrowBalancesResponse, error)
+	// DenomDeployInfo queries whether a contract is mapped to the denom, or one would be auto-deployed by its first
+	// conversion.
+	DenomDeployInfo(ctx context.Context, in *QueryDenomDeployInfoRequest, opts ...grpc.CallOption) (*QueryDenomDeployInfoResponse, error)
+	// ContractsByDenoms resolves the contracts of a list of native denoms, the results are in the order of the request
+	ContractsByDenoms(ctx context.Context, in *QueryContractsByDenomsRequest, opts ...grpc.CallOption) (*QueryContractsByDenomsResponse, error)
+	// CRC20Balance queries the balance of an evm address in the crc20 contract mapped to a native denom
+	CRC20Balance(ctx context.Context, in *QueryCRC20BalanceRequest, opts ...grpc.CallOption) (*QueryCRC20BalanceResponse, error)
+	// CRC20Supply queries the total supply of the crc20 contract mapped to a native denom
+	CRC20Supply(ctx context.Context, in *QueryCRC20SupplyRequest, opts ...grpc.CallOption) (*QueryCRC20SupplyResponse, error)
+	// ConversionHistory queries the recent conversions of an evm address
+	ConversionHistory(ctx context.Context, in *QueryConversionHistoryRequest, opts ...grpc.CallOption) (*QueryConversionHistoryResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ContractByDenom(ctx context.Context, in *ContractByDenomRequest, opts ...grpc.CallOption) (*ContractByDenomResponse, error) {
+	out := new(ContractByDenomResponse)
+	err := c.cc.Invoke(ctx, "/cronos.Query/ContractByDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomByContract(ctx context.Context, in *DenomByContractRequest, opts ...grpc.CallOption) (*DenomByContractResponse, error) {
+	out := new(DenomByContractResponse)
+	err := c.cc.Invoke(ctx, "/cronos.Query/DenomByContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenMappings(ctx context.Context, in *QueryTokenMappingsRequest, opts ...grpc.CallOption) (*QueryTokenMappingsResponse, error) {
+	out := new(QueryTokenMappingsResponse)
+	err := c.cc.Invoke(ctx, "/cronos.Query/TokenMappings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReplayBlock(ctx context.Context, in *ReplayBlockRequest, opts ...grpc.CallOption) (*ReplayBlockResponse, error) {
+	out := new(ReplayBlockResponse)
+	err := c.cc.Invoke(ctx, "/cronos.Query/ReplayBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cronos.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Permissions(ctx context.Context, in *QueryPermissionsRequest, opts ...grpc.CallOption) (*QueryPermissionsResponse, error) {
+	out := new(QueryPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/cronos.Query/Permissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateConversion(ctx context.Context, in *QuerySimulateConversionRequest, opts ...grpc.CallOption) (*QuerySimulateConversionResponse, error) {
+	out := new(QuerySimulateConversionResponse)
+	err := c.cc.Invoke(ctx, "/cronos.Query/SimulateConversion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowBalances(ctx context.Context, in *QueryEscrowBalancesRequest, opts ...grpc.CallOption) (*QueryEscrowBalancesResponse, error) {
+	out := new(QueryEscrowBalancesResponse)
+	err := c.cc.Invoke(ctx, "/cronos.Query/EscrowBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomDeployInfo(ctx context.Context, in *QueryDenomDeployInfoRequest, opts ...grpc.CallOption) (*QueryDenomDeployInfoResponse, error) {
+	out := new(QueryDenomDeployInfoResponse)
+	err := c.cc.Invoke(ctx, "/cronos.Query/DenomDeployInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractsByDenoms(ctx context.Context, in *QueryContractsByDenomsRequest, opts ...grpc.CallOption) (*QueryContractsByDenomsResponse, error) {
+	out := new(QueryContractsByDenomsResponse)
+	err := c.cc.Invoke(ctx, "/cronos.Query/ContractsByDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CRC20Balance(ctx context.Context, in *QueryCRC20BalanceRequest, opts ...grpc.CallOption) (*QueryCRC20BalanceResponse, error) {
+	out := new(QueryCRC20BalanceResponse)
+	err := c.cc.Invoke(ctx, "/cronos.Query/CRC20Balance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CRC20Supply(ctx context.Context, in *QueryCRC20SupplyRequest, opts ...grpc.CallOption) (*QueryCRC20SupplyResponse, error) {
+	out := new(QueryCRC20SupplyResponse)
+	err := c.cc.Invoke(ctx, "/cronos.Query/CRC20Supply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConversionHistory(ctx context.Context, in *QueryConversionHistoryRequest, opts ...grpc.CallOption) (*QueryConversionHistoryResponse, error) {
+	out := new(QueryConversionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cronos.Query/ConversionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ContractByDenom queries contract addresses by native denom
+	ContractByDenom(context.Context, *ContractByDenomRequest) (*ContractByDenomResponse, error)
+	// DenomByContract queries native denom by contract address
+	DenomByContract(context.Context, *DenomByContractRequest) (*DenomByContractResponse, error)
+	// TokenMappings queries all the token mappings, ordered by denom
+	TokenMappings(context.Context, *QueryTokenMappingsRequest) (*QueryTokenMappingsResponse, error)
+	// ReplayBlock replay the eth messages in the block to recover the results of
+	// false-failed txs.
+	ReplayBlock(context.Context, *ReplayBlockRequest) (*ReplayBlockResponse, error)
+	// Params queries all parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Params queries permissions for a specific address..
+	Permissions(context.Context, *QueryPermissionsRequest) (*QueryPermissionsResponse, error)
+	// SimulateConversion previews the result of converting a native coin with MsgConvertVouchers,
+	// without any state change.
+	SimulateConversion(context.Context, *QuerySimulateConversionRequest) (*QuerySimulateConversionResponse, error)
+	// EscrowBalances queries the coins escrowed by the module to back the outstanding crc20 tokens,
+	// ordered by denom.
+	EscrowBalances(context.Context, *QueryEscrowBalancesRequest) (*QueryEscrowBalancesResponse, error)
+	// DenomDeployInfo queries whether a contract is mapped to the denom, or one would be auto-deployed by its first
+	// conversion.
+	DenomDeployInfo(context.Context, *QueryDenomDeployInfoRequest) (*QueryDenomDeployInfoResponse, error)
+	// ContractsByDenoms resolves the contracts of a list of native denoms, the results are in the order of the request
+	ContractsByDenoms(context.Context, *QueryContractsByDenomsRequest) (*QueryContractsByDenomsResponse, error)
+	// CRC20Balance queries the balance of an evm address in the crc20 contract mapped to a native denom
+	CRC20Balance(context.Context, *QueryCRC20BalanceRequest) (*QueryCRC20BalanceResponse, error)
+	// CRC20Supply queries the total supply of the crc20 contract mapped to a native denom
+	CRC20Supply(context.Context, *QueryCRC20SupplyRequest) (*QueryCRC20SupplyResponse, error)
+	// ConversionHistory queries the recent conversions of an evm address
+	ConversionHistory(context.Context, *QueryConversionHistoryRequest) (*QueryConversionHistoryResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ContractByDenom(ctx context.Context, req *ContractByDenomRequest) (*ContractByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractByDenom not implemented")
+}
+func (*UnimplementedQueryServer) DenomByContract(ctx context.Context, req *DenomByContractRequest) (*DenomByContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomByContract not implemented")
+}
+func (*UnimplementedQueryServer) TokenMappings(ctx context.Context, req *QueryTokenMappingsRequest) (*QueryTokenMappingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenMappings not implemented")
+}
+func (*UnimplementedQueryServer) ReplayBlock(ctx context.Context, req *ReplayBlockRequest) (*ReplayBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayBlock not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Permissions(ctx context.Context, req *QueryPermissionsRequest) (*QueryPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Permissions not implemented")
+}
+func (*UnimplementedQueryServer) SimulateConversion(ctx context.Context, req *QuerySimulateConversionRequest) (*QuerySimulateConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateConversion not implemented")
+}
+func (*UnimplementedQueryServer) EscrowBalances(ctx context.Context, req *QueryEscrowBalancesRequest) (*QueryEscrowBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowBalances not implemented")
+}
+func (*UnimplementedQueryServer) DenomDeployInfo(ctx context.Context, req *QueryDenomDeployInfoRequest) (*QueryDenomDeployInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomDeployInfo not implemented")
+}
+func (*UnimplementedQueryServer) ContractsByDenoms(ctx context.Context, req *QueryContractsByDenomsRequest) (*QueryContractsByDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractsByDenoms not implemented")
+}
+func (*UnimplementedQueryServer) CRC20Balance(ctx context.Context, req *QueryCRC20BalanceRequest) (*QueryCRC20BalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CRC20Balance not implemented")
+}
+func (*UnimplementedQueryServer) CRC20Supply(ctx context.Context, req *QueryCRC20SupplyRequest) (*QueryCRC20SupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CRC20Supply not implemented")
+}
+func (*UnimplementedQueryServer) ConversionHistory(ctx context.Context, req *QueryConversionHistoryRequest) (*QueryConversionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConversionHistory not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ContractByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronos.Query/ContractByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractByDenom(ctx, req.(*ContractByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomByContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenomByContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomByContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronos.Query/DenomByContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomByContract(ctx, req.(*DenomByContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenMappingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenMappings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronos.Query/TokenMappings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenMappings(ctx, req.(*QueryTokenMappingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReplayBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReplayBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronos.Query/ReplayBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReplayBlock(ctx, req.(*ReplayBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronos.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Permissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Permissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronos.Query/Permissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Permissions(ctx, req.(*QueryPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateConversionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateConversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronos.Query/SimulateConversion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateConversion(ctx, req.(*QuerySimulateConversionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronos.Query/EscrowBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowBalances(ctx, req.(*QueryEscrowBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomDeployInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomDeployInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomDeployInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronos.Query/DenomDeployInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomDeployInfo(ctx, req.(*QueryDenomDeployInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractsByDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractsByDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractsByDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronos.Query/ContractsByDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractsByDenoms(ctx, req.(*QueryContractsByDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CRC20Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCRC20BalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CRC20Balance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronos.Query/CRC20Balance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CRC20Balance(ctx, req.(*QueryCRC20BalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CRC20Supply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCRC20SupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CRC20Supply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronos.Query/CRC20Supply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CRC20Supply(ctx, req.(*QueryCRC20SupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConversionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConversionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConversionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronos.Query/ConversionHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConversionHistory(ctx, req.(*QueryConversionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cronos.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ContractByDenom",
+			Handler:    _Query_ContractByDenom_Handler,
+		},
+		{
+			MethodName: "DenomByContract",
+			Handler:    _Query_DenomByContract_Handler,
+		},
+		{
+			MethodName: "TokenMappings",
+			Handler:    _Query_TokenMappings_Handler,
+		},
+		{
+			MethodName: "ReplayBlock",
+			Handler:    _Query_ReplayBlock_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Permissions",
+			Handler:    _Query_Permissions_Handler,
+		},
+		{
+			MethodName: "SimulateConversion",
+			Handler:    _Query_SimulateConversion_Handler,
+		},
+		{
+			MethodName: "EscrowBalances",
+			Handler:    _Query_EscrowBalances_Handler,
+		},
+		{
+			MethodName: "DenomDeployInfo",
+			Handler:    _Query_DenomDeployInfo_Handler,
+		},
+		{
+			MethodName: "ContractsByDenoms",
+			Handler:    _Query_ContractsByDenoms_Handler,
+		},
+		{
+			MethodName: "CRC20Balance",
+			Handler:    _Query_CRC20Balance_Handler,
+		},
+		{
+			MethodName: "CRC20Supply",
+			Handler:    _Query_CRC20Supply_Handler,
+		},
+		{
+			MethodName: "ConversionHistory",
+			Handler:    _Query_ConversionHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cronos/query.proto",
+}
+
+func (m *ContractByDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractByDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AutoContract) > 0 {
+		i -= len(m.AutoContract)
+		copy(dAtA[i:], m.AutoContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AutoContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomByContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomByContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomByContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomByContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomByContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomByContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenMappingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenMappingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenMappingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenMappingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenMappingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenMappingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Mappings) > 0 {
+		for iNdEx := len(m.Mappings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenMappingInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenMappingInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenMappingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoDeployed {
+		i--
+		if m.AutoDeployed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsSource {
+		i--
+		if m.IsSource {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPermissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPermissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPermissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPermissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CanTurnBridge {
+		i--
+		if m.CanTurnBridge {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CanChangeTokenMapping {
+		i--
+		if m.CanChangeTokenMapping {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateConversionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateConversionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateConversionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateConversionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateConversionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateConversionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.AutoDeploy {
+		i--
+		if m.AutoDeploy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomDeployInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomDeployInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomDeployInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomDeployInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomDeployInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomDeployInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoDeploymentEnabled {
+		i--
+		if m.AutoDeploymentEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AutoDeployed {
+		i--
+		if m.AutoDeployed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsSource {
+		i--
+		if m.IsSource {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Found {
+		i--
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractsByDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractsByDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsByDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractsByDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractsByDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsByDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Found {
+		i--
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCRC20BalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCRC20BalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCRC20BalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCRC20BalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCRC20BalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCRC20BalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCRC20SupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCRC20SupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCRC20SupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCRC20SupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCRC20SupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCRC20SupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalSupply) > 0 {
+		i -= len(m.TotalSupply)
+		copy(dAtA[i:], m.TotalSupply)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalSupply)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConversionHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConversionHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ContractByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AutoContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomByContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomByContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenMappingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenMappingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mappings) > 0 {
+		for _, e := range m.Mappings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenMappingInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IsSource {
+		n += 2
+	}
+	if m.AutoDeployed {
+		n += 2
+	}
+	return n
+}
+
+func (m *ReplayBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ReplayBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPermissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPermissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CanChangeTokenMapping {
+		n += 2
+	}
+	if m.CanTurnBridge {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySimulateConversionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateConversionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AutoDeploy {
+		n += 2
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEscrowBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomDeployInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomDeployInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Found {
+		n += 2
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IsSource {
+		n += 2
+	}
+	if m.AutoDeployed {
+		n += 2
+	}
+	if m.AutoDeploymentEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryContractsByDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryContractsByDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Found {
+		n += 2
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCRC20BalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCRC20BalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCRC20SupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCRC20SupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TotalSupply)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConversionHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryConversionHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ContractByDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractByDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractByDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractByDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomByContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomByContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomByContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomByContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomByContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomByContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenMappingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenMappingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenMappingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenMappingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenMappingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenMappingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mappings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mappings = append(m.Mappings, TokenMappingInfo{})
+			if err := m.Mappings[len(m.Mappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenMappingInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenMappingInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenMappingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSource", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSource = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoDeployed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoDeployed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplayBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplayBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplayBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.MsgEthereumTx{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplayBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplayBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplayBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, &types.MsgEthereumTxResponse{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPermissionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPermissionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPermissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPermissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanChangeTokenMapping", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanChangeTokenMapping = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanTurnBridge", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanTurnBridge = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateConversionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateConversionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateConversionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateConversionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateConversionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoDeploy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoDeploy = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types1.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomDeployInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomDeployInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomDeployInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryPermissionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CanChangeTokenMapping {
-		n += 2
-	}
-	if m.CanTurnBridge {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ContractByDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomDeployInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1315,15 +5592,35 @@ func (m *ContractByDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractByDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomDeployInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomDeployInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Found = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1351,8 +5648,68 @@ func (m *ContractByDenomRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSource", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSource = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoDeployed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoDeployed = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoDeploymentEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoDeploymentEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1374,7 +5731,7 @@ func (m *ContractByDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractByDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractsByDenomsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1397,15 +5754,15 @@ func (m *ContractByDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractByDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractsByDenomsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractsByDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1433,13 +5790,63 @@ func (m *ContractByDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractsByDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractsByDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractsByDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1449,23 +5856,25 @@ func (m *ContractByDenomResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AutoContract = string(dAtA[iNdEx:postIndex])
+			m.Contracts = append(m.Contracts, DenomContract{})
+			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1488,7 +5897,7 @@ func (m *ContractByDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomByContractRequest) Unmarshal(dAtA []byte) error {
+func (m *DenomContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1511,13 +5920,65 @@ func (m *DenomByContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomByContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomByContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Found = bool(v != 0)
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
@@ -1570,7 +6031,7 @@ func (m *DenomByContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomByContractResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCRC20BalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1593,15 +6054,47 @@ func (m *DenomByContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomByContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCRC20BalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomByContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCRC20BalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1629,7 +6122,7 @@ func (m *DenomByContractResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1652,7 +6145,7 @@ func (m *DenomByContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplayBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCRC20BalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1675,17 +6168,17 @@ func (m *ReplayBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplayBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCRC20BalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplayBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCRC20BalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1695,48 +6188,27 @@ func (m *ReplayBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msgs = append(m.Msgs, &types.MsgEthereumTx{})
-			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
-			}
-			m.BlockNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockNumber |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1764,40 +6236,7 @@ func (m *ReplayBlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1820,7 +6259,7 @@ func (m *ReplayBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplayBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCRC20SupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1843,17 +6282,17 @@ func (m *ReplayBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplayBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCRC20SupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplayBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCRC20SupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1863,25 +6302,23 @@ func (m *ReplayBlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Responses = append(m.Responses, &types.MsgEthereumTxResponse{})
-			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1904,7 +6341,7 @@ func (m *ReplayBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCRC20SupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1927,67 +6364,49 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCRC20SupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCRC20SupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1997,24 +6416,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TotalSupply = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2037,7 +6455,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPermissionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConversionHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2060,10 +6478,10 @@ func (m *QueryPermissionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPermissionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConversionHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConversionHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2098,6 +6516,25 @@ func (m *QueryPermissionsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2119,7 +6556,7 @@ func (m *QueryPermissionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPermissionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConversionHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2142,17 +6579,17 @@ func (m *QueryPermissionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPermissionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConversionHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConversionHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanChangeTokenMapping", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2162,32 +6599,26 @@ func (m *QueryPermissionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CanChangeTokenMapping = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanTurnBridge", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.CanTurnBridge = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, ConversionRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -12,6 +12,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	types1 "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -36,6 +37,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type MsgConvertVouchers struct {
 	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Coins   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// the evm address receiving the converted tokens, defaults to the evm address of the sender
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
 func (m *MsgConvertVouchers) Reset()         { *m = MsgConvertVouchers{} }
@@ -85,12 +88,29 @@ func (m *MsgConvertVouchers) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins
 	return nil
 }
 
+func (m *MsgConvertVouchers) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
 // MsgTransferTokens represents a message to transfer cronos evm coins through
 // ibc.
 type MsgTransferTokens struct {
 	From  string                                   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
 	To    string                                   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
 	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// the channel the cronos originated tokens are sent through, the vouchers are always sent back
+	// through the channel they were received from
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the timeout height of the ibc transfers on the destination chain, disabled if zero
+	TimeoutHeight types1.Height `protobuf:"bytes,5,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height"`
+	// the absolute timeout timestamp of the ibc transfers in nanoseconds, disabled if zero, the module default
+	// timeout is used if both timeouts are zero
+	TimeoutTimestamp uint64 `protobuf:"varint,6,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// the memo of the ibc transfers
+	Memo string `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
 }
 
 func (m *MsgTransferTokens) Reset()         { *m = MsgTransferTokens{} }
@@ -147,6 +167,34 @@ func (m *MsgTransferTokens) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins
 	return nil
 }
 
+func (m *MsgTransferTokens) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *MsgTransferTokens) GetTimeoutHeight() types1.Height {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return types1.Height{}
+}
+
+func (m *MsgTransferTokens) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *MsgTransferTokens) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
 // MsgConvertVouchersResponse defines the ConvertVouchers response type.
 type MsgConvertVouchersResponse struct {
 }
@@ -617,6 +665,346 @@ func (m *MsgUpdatePermissionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdatePermissionsResponse proto.InternalMessageInfo
 
+// MsgConvertCoin represents a message to convert a native coin to the crc20
+// tokens of its registered contract.
+type MsgConvertCoin struct {
+	Sender string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Coin   types.Coin `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin"`
+}
+
+func (m *MsgConvertCoin) Reset()         { *m = MsgConvertCoin{} }
+func (m *MsgConvertCoin) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertCoin) ProtoMessage()    {}
+func (*MsgConvertCoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28e09e4eabb18884, []int{12}
+}
+func (m *MsgConvertCoin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertCoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertCoin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertCoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertCoin.Merge(m, src)
+}
+func (m *MsgConvertCoin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertCoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertCoin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertCoin proto.InternalMessageInfo
+
+func (m *MsgConvertCoin) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgConvertCoin) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
+// MsgConvertCoinResponse defines the ConvertCoin response type.
+type MsgConvertCoinResponse struct {
+}
+
+func (m *MsgConvertCoinResponse) Reset()         { *m = MsgConvertCoinResponse{} }
+func (m *MsgConvertCoinResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertCoinResponse) ProtoMessage()    {}
+func (*MsgConvertCoinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28e09e4eabb18884, []int{13}
+}
+func (m *MsgConvertCoinResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertCoinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertCoinResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertCoinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertCoinResponse.Merge(m, src)
+}
+func (m *MsgConvertCoinResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertCoinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertCoinResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertCoinResponse proto.InternalMessageInfo
+
+// MsgRedeployContract defines the request type for redeploying the auto-deployed
+// contract of a denom which has no code left at its address.
+type MsgRedeployContract struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgRedeployContract) Reset()         { *m = MsgRedeployContract{} }
+func (m *MsgRedeployContract) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeployContract) ProtoMessage()    {}
+func (*MsgRedeployContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28e09e4eabb18884, []int{14}
+}
+func (m *MsgRedeployContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeployContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeployContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeployContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeployContract.Merge(m, src)
+}
+func (m *MsgRedeployContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeployContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeployContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeployContract proto.InternalMessageInfo
+
+func (m *MsgRedeployContract) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRedeployContract) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgRedeployContractResponse defines the response type.
+type MsgRedeployContractResponse struct {
+	// the address of the redeployed contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *MsgRedeployContractResponse) Reset()         { *m = MsgRedeployContractResponse{} }
+func (m *MsgRedeployContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeployContractResponse) ProtoMessage()    {}
+func (*MsgRedeployContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28e09e4eabb18884, []int{15}
+}
+func (m *MsgRedeployContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeployContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeployContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeployContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeployContractResponse.Merge(m, src)
+}
+func (m *MsgRedeployContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeployContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeployContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeployContractResponse proto.InternalMessageInfo
+
+func (m *MsgRedeployContractResponse) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+// MsgConvertAndTransfer represents a message to convert ibc voucher coins to the
+// cronos evm coins of the sender, then to send the converted tokens through ibc,
+// all of it is reverted if any step fails.
+type MsgConvertAndTransfer struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Coins   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// send the converted tokens through ibc, they're only converted if not set
+	Transfer bool `protobuf:"varint,3,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	// the receiver on the counterparty chain
+	To string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// the channel the cronos originated tokens are sent through, the vouchers are always sent back
+	// through the channel they were received from
+	ChannelId string `protobuf:"bytes,5,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the timeout height of the ibc transfers on the destination chain, disabled if zero
+	TimeoutHeight types1.Height `protobuf:"bytes,6,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height"`
+	// the absolute timeout timestamp of the ibc transfers in nanoseconds, disabled if zero, the module default
+	// timeout is used if both timeouts are zero
+	TimeoutTimestamp uint64 `protobuf:"varint,7,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// the memo of the ibc transfers
+	Memo string `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *MsgConvertAndTransfer) Reset()         { *m = MsgConvertAndTransfer{} }
+func (m *MsgConvertAndTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertAndTransfer) ProtoMessage()    {}
+func (*MsgConvertAndTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28e09e4eabb18884, []int{16}
+}
+func (m *MsgConvertAndTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertAndTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertAndTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertAndTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertAndTransfer.Merge(m, src)
+}
+func (m *MsgConvertAndTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertAndTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertAndTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertAndTransfer proto.InternalMessageInfo
+
+func (m *MsgConvertAndTransfer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgConvertAndTransfer) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *MsgConvertAndTransfer) GetTransfer() bool {
+	if m != nil {
+		return m.Transfer
+	}
+	return false
+}
+
+func (m *MsgConvertAndTransfer) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *MsgConvertAndTransfer) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *MsgConvertAndTransfer) GetTimeoutHeight() types1.Height {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return types1.Height{}
+}
+
+func (m *MsgConvertAndTransfer) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *MsgConvertAndTransfer) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+// MsgConvertAndTransferResponse defines the ConvertAndTransfer response type.
+type MsgConvertAndTransferResponse struct {
+}
+
+func (m *MsgConvertAndTransferResponse) Reset()         { *m = MsgConvertAndTransferResponse{} }
+func (m *MsgConvertAndTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertAndTransferResponse) ProtoMessage()    {}
+func (*MsgConvertAndTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28e09e4eabb18884, []int{17}
+}
+func (m *MsgConvertAndTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertAndTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertAndTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertAndTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertAndTransferResponse.Merge(m, src)
+}
+func (m *MsgConvertAndTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertAndTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertAndTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertAndTransferResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgConvertVouchers)(nil), "cronos.MsgConvertVouchers")
 	proto.RegisterType((*MsgTransferTokens)(nil), "cronos.MsgTransferTokens")
@@ -630,57 +1018,81 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cronos.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgUpdatePermissions)(nil), "cronos.MsgUpdatePermissions")
 	proto.RegisterType((*MsgUpdatePermissionsResponse)(nil), "cronos.MsgUpdatePermissionsResponse")
+	proto.RegisterType((*MsgConvertCoin)(nil), "cronos.MsgConvertCoin")
+	proto.RegisterType((*MsgConvertCoinResponse)(nil), "cronos.MsgConvertCoinResponse")
+	proto.RegisterType((*MsgRedeployContract)(nil), "cronos.MsgRedeployContract")
+	proto.RegisterType((*MsgRedeployContractResponse)(nil), "cronos.MsgRedeployContractResponse")
+	proto.RegisterType((*MsgConvertAndTransfer)(nil), "cronos.MsgConvertAndTransfer")
+	proto.RegisterType((*MsgConvertAndTransferResponse)(nil), "cronos.MsgConvertAndTransferResponse")
 }
 
 func init() { proto.RegisterFile("cronos/tx.proto", fileDescriptor_28e09e4eabb18884) }
 
 var fileDescriptor_28e09e4eabb18884 = []byte{
-	// 709 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0x8f, 0xf3, 0x8f, 0xf6, 0xa5, 0x4d, 0xd5, 0xa3, 0x6d, 0x12, 0x93, 0x3a, 0x21, 0x02, 0x29,
-	0xaa, 0x68, 0x4c, 0x03, 0x53, 0xc7, 0x74, 0x61, 0x20, 0x15, 0x44, 0x05, 0xa4, 0x6e, 0x8e, 0x7d,
-	0x75, 0xac, 0xd6, 0x3e, 0x73, 0x77, 0x89, 0x9a, 0x0d, 0xf1, 0x09, 0xf8, 0x06, 0xb0, 0xb0, 0x30,
-	0xf5, 0x63, 0x74, 0xec, 0xc8, 0x04, 0xa8, 0x1d, 0xba, 0xf3, 0x09, 0x90, 0xcf, 0x67, 0xc7, 0x69,
-	0x92, 0x6e, 0x4c, 0xbe, 0xf7, 0x7e, 0xf7, 0x7e, 0xbf, 0xdf, 0xf3, 0xbd, 0xb3, 0x61, 0xcd, 0xa4,
-	0xc4, 0x23, 0x4c, 0xe7, 0xe7, 0x2d, 0x9f, 0x12, 0x4e, 0x50, 0x3e, 0x4c, 0xa8, 0x25, 0x93, 0x30,
-	0x97, 0x30, 0xdd, 0x65, 0xb6, 0x3e, 0xda, 0x0b, 0x1e, 0xe1, 0x06, 0x75, 0xc3, 0x26, 0x36, 0x11,
-	0x4b, 0x3d, 0x58, 0xc9, 0xac, 0x26, 0xb7, 0xf7, 0x0d, 0x86, 0xf5, 0xd1, 0x5e, 0x1f, 0x73, 0x63,
-	0x4f, 0x37, 0x89, 0xe3, 0x49, 0xfc, 0xa1, 0xd4, 0x09, 0x1f, 0x61, 0xb2, 0xf1, 0x55, 0x01, 0xd4,
-	0x65, 0xf6, 0x01, 0xf1, 0x46, 0x98, 0xf2, 0xf7, 0x64, 0x68, 0x0e, 0x30, 0x65, 0xa8, 0x0c, 0x0f,
-	0x0c, 0xcb, 0xa2, 0x98, 0xb1, 0xb2, 0x52, 0x57, 0x9a, 0xcb, 0xbd, 0x28, 0x44, 0x06, 0xe4, 0x02,
-	0x4e, 0x56, 0x4e, 0xd7, 0x33, 0xcd, 0x42, 0xbb, 0xd2, 0x0a, 0x55, 0x5b, 0x81, 0x6a, 0x4b, 0xaa,
-	0xb6, 0x0e, 0x88, 0xe3, 0x75, 0x9e, 0x5f, 0xfe, 0xaa, 0xa5, 0x7e, 0xfc, 0xae, 0x35, 0x6d, 0x87,
-	0x0f, 0x86, 0xfd, 0x96, 0x49, 0x5c, 0x5d, 0x5a, 0x0c, 0x1f, 0xbb, 0xcc, 0x3a, 0xd5, 0xf9, 0xd8,
-	0xc7, 0x4c, 0x14, 0xb0, 0x5e, 0xc8, 0xbc, 0xbf, 0xf2, 0xf9, 0xf6, 0x62, 0x27, 0x12, 0x6c, 0x7c,
-	0x57, 0x60, 0xbd, 0xcb, 0xec, 0x23, 0x6a, 0x78, 0xec, 0x04, 0xd3, 0x23, 0x72, 0x8a, 0x3d, 0x86,
-	0x10, 0x64, 0x4f, 0x28, 0x71, 0xa5, 0x3b, 0xb1, 0x46, 0x45, 0x48, 0x73, 0x52, 0x4e, 0x8b, 0x4c,
-	0x9a, 0x93, 0x89, 0xd5, 0xcc, 0x7f, 0xb3, 0xba, 0x1c, 0x58, 0x15, 0xea, 0x8d, 0x2a, 0xa8, 0xb3,
-	0x2f, 0xb2, 0x87, 0x99, 0x4f, 0x3c, 0x86, 0x1b, 0x8f, 0xa0, 0x32, 0xd3, 0x44, 0x0c, 0x7e, 0x53,
-	0x60, 0xb3, 0xcb, 0xec, 0x77, 0xbe, 0x65, 0x70, 0x2c, 0xb0, 0xae, 0xe1, 0xfb, 0x8e, 0x67, 0xa3,
-	0x2d, 0xc8, 0x33, 0xec, 0x59, 0x98, 0xca, 0x46, 0x65, 0x84, 0x36, 0x20, 0x67, 0x61, 0x8f, 0xb8,
-	0xb2, 0xdb, 0x30, 0x40, 0x2a, 0x2c, 0x99, 0xc4, 0xe3, 0xd4, 0x30, 0x79, 0x39, 0x23, 0x80, 0x38,
-	0x16, 0x4c, 0x63, 0xb7, 0x4f, 0xce, 0xca, 0x59, 0xc9, 0x24, 0xa2, 0xe0, 0xa4, 0x2d, 0x6c, 0x3a,
-	0xae, 0x71, 0x56, 0xce, 0xd5, 0x95, 0xe6, 0x6a, 0x2f, 0x0a, 0xf7, 0x0b, 0x41, 0x6f, 0x52, 0xb0,
-	0x51, 0x83, 0xed, 0xb9, 0x0e, 0xe3, 0x1e, 0x5e, 0xc3, 0x6a, 0xd0, 0xe0, 0x90, 0x7a, 0x1d, 0xea,
-	0x58, 0x36, 0x5e, 0x68, 0x7d, 0x0b, 0xf2, 0xd8, 0x33, 0xfa, 0x67, 0x58, 0x78, 0x5f, 0xea, 0xc9,
-	0x68, 0x5a, 0xae, 0x24, 0x5e, 0xc8, 0x84, 0x2d, 0x96, 0x71, 0x61, 0x2d, 0xf6, 0xf1, 0xc6, 0xa0,
-	0x86, 0xcb, 0x50, 0x15, 0x96, 0x8d, 0x21, 0x1f, 0x10, 0xea, 0xf0, 0xb1, 0xd4, 0x9a, 0x24, 0xd0,
-	0x33, 0xc8, 0xfb, 0x62, 0x9f, 0x90, 0x2b, 0xb4, 0x8b, 0x2d, 0x39, 0xff, 0x61, 0x75, 0x27, 0x1b,
-	0x1c, 0x7d, 0x4f, 0xee, 0xd9, 0x2f, 0x06, 0x26, 0x26, 0xd5, 0x8d, 0x0a, 0x94, 0xee, 0xc8, 0xc5,
-	0x4e, 0x3e, 0xc2, 0xc6, 0x04, 0xc2, 0xd4, 0x75, 0x18, 0x73, 0xc8, 0x82, 0xc9, 0x4c, 0x5c, 0xa7,
-	0xf4, 0xf4, 0x75, 0xaa, 0x43, 0xc1, 0x9f, 0x14, 0x8b, 0x53, 0xcb, 0xf6, 0x92, 0xa9, 0xe4, 0x88,
-	0x69, 0x50, 0x9d, 0x27, 0x19, 0x59, 0x6a, 0xff, 0xcd, 0x40, 0xa6, 0xcb, 0x6c, 0xf4, 0x16, 0xd6,
-	0xee, 0x5e, 0x68, 0x35, 0x6a, 0x7b, 0x76, 0x46, 0xd5, 0xc6, 0x62, 0x2c, 0xa2, 0x46, 0x87, 0x50,
-	0xbc, 0x73, 0x03, 0x2b, 0x89, 0xaa, 0x69, 0x48, 0x7d, 0xbc, 0x10, 0x8a, 0xf9, 0x8e, 0x01, 0xcd,
-	0x19, 0xf7, 0xed, 0x44, 0xe1, 0x2c, 0xac, 0x3e, 0xbd, 0x17, 0x8e, 0xb9, 0x3b, 0x00, 0x89, 0x39,
-	0xdc, 0x4c, 0x9a, 0x89, 0xd3, 0xea, 0xf6, 0xdc, 0x74, 0xcc, 0xf1, 0x0a, 0x56, 0xa6, 0x86, 0xac,
-	0x34, 0x23, 0x1d, 0x02, 0x6a, 0x6d, 0x01, 0x10, 0x33, 0x7d, 0x80, 0xf5, 0xd9, 0x21, 0xa9, 0xce,
-	0x56, 0x4d, 0x50, 0xf5, 0xc9, 0x7d, 0x68, 0x44, 0xac, 0xe6, 0x3e, 0xdd, 0x5e, 0xec, 0x28, 0x9d,
-	0xc3, 0xcb, 0x6b, 0x4d, 0xb9, 0xba, 0xd6, 0x94, 0x3f, 0xd7, 0x9a, 0xf2, 0xe5, 0x46, 0x4b, 0x5d,
-	0xdd, 0x68, 0xa9, 0x9f, 0x37, 0x5a, 0xea, 0xf8, 0x65, 0xf2, 0x6b, 0x46, 0xc7, 0x3e, 0x27, 0xbb,
-	0x84, 0xda, 0xbb, 0xe6, 0xc0, 0x70, 0x3c, 0xf9, 0x17, 0xd0, 0x47, 0x6d, 0xfd, 0x3c, 0x5a, 0x8b,
-	0xef, 0x5b, 0x3f, 0x2f, 0x7e, 0x0c, 0x2f, 0xfe, 0x05, 0x00, 0x00, 0xff, 0xff, 0x29, 0xd8, 0x30,
-	0x36, 0x97, 0x06, 0x00, 0x00,
+	// 1005 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0x8f, 0x1c, 0xdb, 0x75, 0x9e, 0x1b, 0xa7, 0x51, 0xf3, 0x47, 0x51, 0xe2, 0x3f, 0x18, 0x3a,
+	0xe3, 0x29, 0x44, 0x22, 0x2e, 0x17, 0x72, 0xc3, 0x19, 0x86, 0x32, 0x83, 0x3b, 0xa0, 0x09, 0x30,
+	0xf4, 0xd2, 0x91, 0xa5, 0xad, 0x2c, 0x6a, 0x69, 0xc5, 0xee, 0xda, 0x53, 0xdf, 0x18, 0x3e, 0x01,
+	0x1f, 0x81, 0x33, 0xa7, 0x7e, 0x01, 0xee, 0x3d, 0x31, 0x3d, 0x72, 0x02, 0x26, 0x39, 0xf4, 0xc0,
+	0x07, 0xe0, 0xca, 0xec, 0x6a, 0x25, 0x4b, 0x91, 0xed, 0x5e, 0x60, 0x38, 0x69, 0xf7, 0xfd, 0xf6,
+	0xbd, 0xf7, 0xdb, 0xf7, 0xde, 0xfe, 0x6c, 0xd8, 0x71, 0x08, 0x0e, 0x31, 0x35, 0xd9, 0x73, 0x23,
+	0x22, 0x98, 0x61, 0xb5, 0x1a, 0x1b, 0xf4, 0x43, 0x07, 0xd3, 0x00, 0x53, 0x33, 0xa0, 0x9e, 0x39,
+	0x3b, 0xe3, 0x9f, 0xf8, 0x80, 0xbe, 0xe7, 0x61, 0x0f, 0x8b, 0xa5, 0xc9, 0x57, 0xd2, 0xda, 0x92,
+	0xc7, 0x47, 0x36, 0x45, 0xe6, 0xec, 0x6c, 0x84, 0x98, 0x7d, 0x66, 0x3a, 0xd8, 0x0f, 0x25, 0x7e,
+	0x57, 0xe6, 0x89, 0x3f, 0xd2, 0xd8, 0xf6, 0x47, 0x8e, 0xe9, 0x60, 0x82, 0x4c, 0x67, 0xe2, 0xa3,
+	0x90, 0xf1, 0x44, 0xf1, 0x2a, 0x3e, 0xd0, 0xfd, 0x45, 0x01, 0x75, 0x48, 0xbd, 0x0b, 0x1c, 0xce,
+	0x10, 0x61, 0x5f, 0xe1, 0xa9, 0x33, 0x46, 0x84, 0xaa, 0x1a, 0xdc, 0xb2, 0x5d, 0x97, 0x20, 0x4a,
+	0x35, 0xa5, 0xa3, 0xf4, 0xb6, 0xac, 0x64, 0xab, 0xda, 0x50, 0xe1, 0x49, 0xa9, 0x56, 0xea, 0x6c,
+	0xf6, 0xea, 0xfd, 0x23, 0x23, 0xa6, 0x65, 0x70, 0x5a, 0x86, 0xa4, 0x65, 0x5c, 0x60, 0x3f, 0x1c,
+	0xbc, 0xff, 0xf2, 0xf7, 0xf6, 0xc6, 0xcf, 0x7f, 0xb4, 0x7b, 0x9e, 0xcf, 0xc6, 0xd3, 0x91, 0xe1,
+	0xe0, 0xc0, 0x94, 0x77, 0x88, 0x3f, 0xa7, 0xd4, 0x7d, 0x66, 0xb2, 0x79, 0x84, 0xa8, 0x70, 0xa0,
+	0x56, 0x1c, 0x59, 0x3d, 0x81, 0x2d, 0x82, 0x1c, 0x3f, 0xe2, 0x34, 0xb5, 0x4d, 0x91, 0x7e, 0x61,
+	0x38, 0xbf, 0xfd, 0xc3, 0xeb, 0x17, 0xf7, 0x13, 0x3a, 0xdd, 0x5f, 0x4b, 0xb0, 0x3b, 0xa4, 0xde,
+	0x25, 0xb1, 0x43, 0xfa, 0x14, 0x91, 0x4b, 0xfc, 0x0c, 0x85, 0x54, 0x55, 0xa1, 0xfc, 0x94, 0xe0,
+	0x40, 0x72, 0x17, 0x6b, 0xb5, 0x01, 0x25, 0x86, 0xb5, 0x92, 0xb0, 0x94, 0x18, 0x5e, 0x5c, 0x64,
+	0xf3, 0x3f, 0xbb, 0x48, 0x13, 0xc0, 0x19, 0xdb, 0x61, 0x88, 0x26, 0x4f, 0x7c, 0x57, 0x2b, 0xc7,
+	0x37, 0x91, 0x96, 0x4f, 0x5d, 0xf5, 0x13, 0x68, 0x30, 0x3f, 0x40, 0x78, 0xca, 0x9e, 0x8c, 0x91,
+	0xef, 0x8d, 0x99, 0x56, 0xe9, 0x28, 0xbd, 0x7a, 0x5f, 0x37, 0xfc, 0x91, 0x63, 0xf0, 0xae, 0x19,
+	0xb2, 0x57, 0xb3, 0x33, 0xe3, 0xa1, 0x38, 0x31, 0x28, 0x73, 0x2e, 0xd6, 0xb6, 0xf4, 0x8b, 0x8d,
+	0xea, 0xbb, 0xb0, 0x9b, 0x04, 0xe2, 0x5f, 0xca, 0xec, 0x20, 0xd2, 0xaa, 0x1d, 0xa5, 0x57, 0xb6,
+	0xee, 0x48, 0xe0, 0x32, 0xb1, 0xf3, 0xda, 0x04, 0x28, 0xc0, 0xda, 0xad, 0xb8, 0x36, 0x7c, 0x7d,
+	0xbe, 0xc5, 0x6b, 0x2a, 0xca, 0xd4, 0x3d, 0x01, 0xbd, 0x38, 0x0f, 0x16, 0xa2, 0x11, 0x0e, 0x29,
+	0xea, 0x1e, 0xc3, 0x51, 0xa1, 0xda, 0x29, 0xf8, 0x93, 0x02, 0xfb, 0x43, 0xea, 0x7d, 0x19, 0xb9,
+	0x36, 0x43, 0x02, 0x1b, 0xda, 0x51, 0xe4, 0x87, 0x9e, 0x7a, 0x00, 0x55, 0x8a, 0x42, 0x17, 0x11,
+	0xd9, 0x11, 0xb9, 0x53, 0xf7, 0xa0, 0xe2, 0xa2, 0x10, 0x07, 0xb2, 0x2d, 0xf1, 0x46, 0xd5, 0xa1,
+	0xe6, 0xe0, 0x90, 0x11, 0xdb, 0x49, 0xda, 0x9f, 0xee, 0x45, 0xa4, 0x79, 0x30, 0xc2, 0x13, 0x59,
+	0x4e, 0xb9, 0xe3, 0x03, 0xeb, 0x22, 0xc7, 0x0f, 0xec, 0x89, 0x28, 0xe2, 0xb6, 0x95, 0x6c, 0xcf,
+	0xeb, 0xfc, 0x6e, 0x32, 0x61, 0xb7, 0x0d, 0xcd, 0xa5, 0x0c, 0xd3, 0x3b, 0x7c, 0x06, 0xdb, 0xfc,
+	0x82, 0x53, 0x12, 0x0e, 0x88, 0xef, 0x7a, 0x68, 0x25, 0xf5, 0x03, 0xa8, 0xa2, 0xd0, 0x1e, 0x4d,
+	0x90, 0xe0, 0x5e, 0xb3, 0xe4, 0x2e, 0x9f, 0xee, 0x10, 0xf6, 0x73, 0xd1, 0xd2, 0x34, 0x01, 0xec,
+	0xa4, 0x3c, 0x3e, 0xb7, 0x89, 0x1d, 0x88, 0xa9, 0xb7, 0xa7, 0x6c, 0x8c, 0x89, 0xcf, 0xe6, 0x32,
+	0xd7, 0xc2, 0xa0, 0xbe, 0x07, 0xd5, 0x48, 0x9c, 0x13, 0xe9, 0xea, 0xfd, 0x86, 0x21, 0xdf, 0x79,
+	0xec, 0x2d, 0xe7, 0x42, 0x9e, 0x39, 0x6f, 0x70, 0x12, 0x0b, 0xef, 0xee, 0x11, 0x1c, 0xde, 0x48,
+	0x97, 0x32, 0xf9, 0x0e, 0xf6, 0x16, 0x10, 0x22, 0x81, 0x4f, 0xa9, 0x8f, 0x57, 0x3c, 0xa1, 0x8c,
+	0x2a, 0x94, 0xf2, 0xaa, 0xd0, 0x81, 0x7a, 0xb4, 0x70, 0x16, 0x5d, 0x2b, 0x5b, 0x59, 0x53, 0x76,
+	0xc4, 0x5a, 0x70, 0xb2, 0x2c, 0x65, 0x4a, 0xe9, 0x5b, 0x68, 0x2c, 0x46, 0x90, 0x3f, 0xa8, 0x95,
+	0x4d, 0x78, 0x00, 0x65, 0xfe, 0xd2, 0x64, 0x4d, 0xd6, 0x3c, 0xe1, 0xb8, 0x3c, 0xe2, 0x70, 0xbe,
+	0x43, 0x1a, 0x1c, 0xe4, 0x73, 0xa5, 0x2c, 0xbe, 0x81, 0xbb, 0x43, 0xea, 0x59, 0xc8, 0x45, 0xd1,
+	0x04, 0xcf, 0x2f, 0x92, 0x01, 0x5c, 0xdf, 0xa6, 0xa5, 0x03, 0x5d, 0x68, 0xc7, 0x87, 0x70, 0xbc,
+	0x24, 0x74, 0x92, 0x39, 0x37, 0xff, 0x4a, 0x7e, 0xfe, 0xbb, 0x7f, 0x97, 0x60, 0x7f, 0x41, 0xf8,
+	0xa3, 0xd0, 0x4d, 0xde, 0xe2, 0xff, 0x2b, 0xd9, 0x3a, 0xd4, 0x98, 0x24, 0x22, 0x9a, 0x5f, 0xb3,
+	0xd2, 0xbd, 0x14, 0xde, 0x72, 0x2a, 0xbc, 0x79, 0x55, 0xac, 0xbc, 0x59, 0x15, 0xab, 0xff, 0xa2,
+	0x2a, 0xde, 0x7a, 0x83, 0x2a, 0xd6, 0x32, 0xaa, 0x98, 0xff, 0xa5, 0x89, 0xa5, 0xa3, 0x58, 0xf8,
+	0xa4, 0x6d, 0xfd, 0xbf, 0x2a, 0xb0, 0x39, 0xa4, 0x9e, 0xfa, 0x05, 0xec, 0xdc, 0xfc, 0x39, 0xd5,
+	0x93, 0xd7, 0x5a, 0x94, 0x56, 0xbd, 0xbb, 0x1a, 0x4b, 0x27, 0xe2, 0x11, 0x34, 0x6e, 0xfc, 0xc2,
+	0x1d, 0x65, 0xbc, 0xf2, 0x90, 0xfe, 0xd6, 0x4a, 0x28, 0x8d, 0xf7, 0x18, 0xd4, 0x25, 0x2a, 0xdd,
+	0xcc, 0x38, 0x16, 0x61, 0xfd, 0xde, 0x5a, 0x38, 0x8d, 0x3d, 0x00, 0xc8, 0xc8, 0xe7, 0x7e, 0x96,
+	0x4c, 0x6a, 0xd6, 0x9b, 0x4b, 0xcd, 0x69, 0x8c, 0x87, 0x70, 0x3b, 0xa7, 0x8d, 0x87, 0x85, 0xd4,
+	0x31, 0xa0, 0xb7, 0x57, 0x00, 0x69, 0xa4, 0xaf, 0x61, 0xb7, 0xa8, 0x6d, 0x27, 0x45, 0xaf, 0x05,
+	0xaa, 0xbf, 0xb3, 0x0e, 0x4d, 0x03, 0x7f, 0x0c, 0xf5, 0x9c, 0x42, 0x15, 0xbb, 0xc8, 0xed, 0x7a,
+	0x6b, 0xb9, 0x3d, 0x0d, 0x73, 0x09, 0x77, 0x0a, 0x12, 0x73, 0x9c, 0xf1, 0xb9, 0x09, 0xea, 0x6f,
+	0xaf, 0x01, 0xb3, 0xfd, 0x5d, 0xa2, 0x10, 0xcd, 0x22, 0x97, 0x0c, 0xac, 0xdf, 0x5b, 0x0b, 0x27,
+	0xb1, 0xf5, 0xca, 0xf7, 0xaf, 0x5f, 0xdc, 0x57, 0x06, 0x8f, 0x5e, 0x5e, 0xb5, 0x94, 0x57, 0x57,
+	0x2d, 0xe5, 0xcf, 0xab, 0x96, 0xf2, 0xe3, 0x75, 0x6b, 0xe3, 0xd5, 0x75, 0x6b, 0xe3, 0xb7, 0xeb,
+	0xd6, 0xc6, 0xe3, 0x0f, 0xb2, 0xe2, 0x41, 0xe6, 0x11, 0xc3, 0xa7, 0x98, 0x78, 0xa7, 0xce, 0xd8,
+	0xf6, 0x43, 0xf9, 0xef, 0xd4, 0x9c, 0xf5, 0xcd, 0xe7, 0xc9, 0x5a, 0xc8, 0xc9, 0xa8, 0x2a, 0xfe,
+	0x8f, 0x3e, 0xf8, 0x67, 0x00, 0xd0, 0xd5, 0xe6, 0x9f, 0x2f, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -709,6 +1121,15 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// UpdatePermissions defines a method to update cronos admins permissions
 	UpdatePermissions(ctx context.Context, in *MsgUpdatePermissions, opts ...grpc.CallOption) (*MsgUpdatePermissionsResponse, error)
+	// ConvertCoin defines a method for converting a native coin with a registered
+	// contract to crc20 tokens.
+	ConvertCoin(ctx context.Context, in *MsgConvertCoin, opts ...grpc.CallOption) (*MsgConvertCoinResponse, error)
+	// RedeployContract defines a method to redeploy the auto-deployed contract of a
+	// denom which has no code left at its address.
+	RedeployContract(ctx context.Context, in *MsgRedeployContract, opts ...grpc.CallOption) (*MsgRedeployContractResponse, error)
+	// ConvertAndTransfer defines a method for converting ibc voucher coins to cronos
+	// evm coins, then sending the converted tokens back out through ibc, atomically.
+	ConvertAndTransfer(ctx context.Context, in *MsgConvertAndTransfer, opts ...grpc.CallOption) (*MsgConvertAndTransferResponse, error)
 }
 
 type msgClient struct {
@@ -773,6 +1194,33 @@ func (c *msgClient) UpdatePermissions(ctx context.Context, in *MsgUpdatePermissi
 	return out, nil
 }
 
+func (c *msgClient) ConvertCoin(ctx context.Context, in *MsgConvertCoin, opts ...grpc.CallOption) (*MsgConvertCoinResponse, error) {
+	out := new(MsgConvertCoinResponse)
+	err := c.cc.Invoke(ctx, "/cronos.Msg/ConvertCoin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedeployContract(ctx context.Context, in *MsgRedeployContract, opts ...grpc.CallOption) (*MsgRedeployContractResponse, error) {
+	out := new(MsgRedeployContractResponse)
+	err := c.cc.Invoke(ctx, "/cronos.Msg/RedeployContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConvertAndTransfer(ctx context.Context, in *MsgConvertAndTransfer, opts ...grpc.CallOption) (*MsgConvertAndTransferResponse, error) {
+	out := new(MsgConvertAndTransferResponse)
+	err := c.cc.Invoke(ctx, "/cronos.Msg/ConvertAndTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ConvertVouchers defines a method for converting ibc voucher to cronos evm
@@ -789,6 +1237,15 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// UpdatePermissions defines a method to update cronos admins permissions
 	UpdatePermissions(context.Context, *MsgUpdatePermissions) (*MsgUpdatePermissionsResponse, error)
+	// ConvertCoin defines a method for converting a native coin with a registered
+	// contract to crc20 tokens.
+	ConvertCoin(context.Context, *MsgConvertCoin) (*MsgConvertCoinResponse, error)
+	// RedeployContract defines a method to redeploy the auto-deployed contract of a
+	// denom which has no code left at its address.
+	RedeployContract(context.Context, *MsgRedeployContract) (*MsgRedeployContractResponse, error)
+	// ConvertAndTransfer defines a method for converting ibc voucher coins to cronos
+	// evm coins, then sending the converted tokens back out through ibc, atomically.
+	ConvertAndTransfer(context.Context, *MsgConvertAndTransfer) (*MsgConvertAndTransferResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -813,6 +1270,15 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) UpdatePermissions(ctx context.Context, req *MsgUpdatePermissions) (*MsgUpdatePermissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePermissions not implemented")
 }
+func (*UnimplementedMsgServer) ConvertCoin(ctx context.Context, req *MsgConvertCoin) (*MsgConvertCoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoin not implemented")
+}
+func (*UnimplementedMsgServer) RedeployContract(ctx context.Context, req *MsgRedeployContract) (*MsgRedeployContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeployContract not implemented")
+}
+func (*UnimplementedMsgServer) ConvertAndTransfer(ctx context.Context, req *MsgConvertAndTransfer) (*MsgConvertAndTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertAndTransfer not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -926,20 +1392,74 @@ func _Msg_UpdatePermissions_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cronos.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ConvertVouchers",
-			Handler:    _Msg_ConvertVouchers_Handler,
-		},
-		{
-			MethodName: "TransferTokens",
-			Handler:    _Msg_TransferTokens_Handler,
-		},
-		{
-			MethodName: "UpdateTokenMapping",
+func _Msg_ConvertCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertCoin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertCoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronos.Msg/ConvertCoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertCoin(ctx, req.(*MsgConvertCoin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedeployContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeployContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedeployContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronos.Msg/RedeployContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeployContract(ctx, req.(*MsgRedeployContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertAndTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertAndTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertAndTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronos.Msg/ConvertAndTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertAndTransfer(ctx, req.(*MsgConvertAndTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cronos.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ConvertVouchers",
+			Handler:    _Msg_ConvertVouchers_Handler,
+		},
+		{
+			MethodName: "TransferTokens",
+			Handler:    _Msg_TransferTokens_Handler,
+		},
+		{
+			MethodName: "UpdateTokenMapping",
 			Handler:    _Msg_UpdateTokenMapping_Handler,
 		},
 		{
@@ -954,6 +1474,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePermissions",
 			Handler:    _Msg_UpdatePermissions_Handler,
 		},
+		{
+			MethodName: "ConvertCoin",
+			Handler:    _Msg_ConvertCoin_Handler,
+		},
+		{
+			MethodName: "RedeployContract",
+			Handler:    _Msg_RedeployContract_Handler,
+		},
+		{
+			MethodName: "ConvertAndTransfer",
+			Handler:    _Msg_ConvertAndTransfer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cronos/tx.proto",
@@ -979,6 +1511,13 @@ func (m *MsgConvertVouchers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Coins) > 0 {
 		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1023,6 +1562,35 @@ func (m *MsgTransferTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Coins) > 0 {
 		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1370,132 +1938,392 @@ func (m *MsgUpdatePermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgConvertCoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgConvertVouchers) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+
+func (m *MsgConvertCoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTransferTokens) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgConvertCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConvertVouchersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgConvertCoinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgTransferTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgConvertCoinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateTokenMapping) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRedeployContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeployContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeployContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Decimal != 0 {
-		n += 1 + sovTx(uint64(m.Decimal))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateTokenMappingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRedeployContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgTurnBridge) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRedeployContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeployContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Enable {
-		n += 2
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTurnBridgeResponse) Size() (n int) {
-	if m == nil {
+func (m *MsgConvertAndTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertAndTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertAndTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Transfer {
+		i--
+		if m.Transfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertAndTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertAndTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertAndTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgConvertVouchers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertVouchersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateTokenMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Decimal != 0 {
+		n += 1 + sovTx(uint64(m.Decimal))
+	}
+	return n
+}
+
+func (m *MsgUpdateTokenMappingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTurnBridge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enable {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgTurnBridgeResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1556,13 +2384,874 @@ func (m *MsgUpdatePermissionsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgConvertCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgConvertCoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedeployContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRedeployContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertAndTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Transfer {
+		n += 2
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertAndTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgConvertVouchers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertVouchers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertVouchers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertVouchersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertVouchersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertVouchersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTokenMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTokenMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTokenMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimal", wireType)
+			}
+			m.Decimal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimal |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTokenMappingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTokenMappingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTokenMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgConvertVouchers) Unmarshal(dAtA []byte) error {
+func (m *MsgTurnBridge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1585,15 +3274,15 @@ func (m *MsgConvertVouchers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertVouchers: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTurnBridge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertVouchers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTurnBridge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1621,13 +3310,13 @@ func (m *MsgConvertVouchers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1637,26 +3326,62 @@ func (m *MsgConvertVouchers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+			m.Enable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTurnBridgeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTurnBridgeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTurnBridgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1678,7 +3403,7 @@ func (m *MsgConvertVouchers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1701,15 +3426,15 @@ func (m *MsgTransferTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1737,43 +3462,11 @@ func (m *MsgTransferTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.To = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1800,8 +3493,7 @@ func (m *MsgTransferTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1826,57 +3518,7 @@ func (m *MsgTransferTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertVouchersResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertVouchersResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertVouchersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgTransferTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1899,10 +3541,10 @@ func (m *MsgTransferTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1926,7 +3568,7 @@ func (m *MsgTransferTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateTokenMapping) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePermissions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1949,15 +3591,15 @@ func (m *MsgUpdateTokenMapping) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTokenMapping: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePermissions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTokenMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePermissions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1985,75 +3627,11 @@ func (m *MsgUpdateTokenMapping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2081,13 +3659,13 @@ func (m *MsgUpdateTokenMapping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
-			m.Decimal = 0
+			m.Permissions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2097,7 +3675,7 @@ func (m *MsgUpdateTokenMapping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decimal |= uint32(b&0x7F) << shift
+				m.Permissions |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2123,7 +3701,7 @@ func (m *MsgUpdateTokenMapping) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateTokenMappingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePermissionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2146,10 +3724,10 @@ func (m *MsgUpdateTokenMappingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTokenMappingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePermissionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTokenMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2173,7 +3751,7 @@ func (m *MsgUpdateTokenMappingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTurnBridge) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2196,10 +3774,10 @@ func (m *MsgTurnBridge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTurnBridge: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertCoin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTurnBridge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertCoin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2235,10 +3813,10 @@ func (m *MsgTurnBridge) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2248,12 +3826,25 @@ func (m *MsgTurnBridge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enable = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2275,7 +3866,7 @@ func (m *MsgTurnBridge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTurnBridgeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2298,10 +3889,10 @@ func (m *MsgTurnBridgeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTurnBridgeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertCoinResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTurnBridgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2325,7 +3916,7 @@ func (m *MsgTurnBridgeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeployContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2348,10 +3939,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeployContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeployContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2388,9 +3979,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2400,24 +3991,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2440,7 +4030,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeployContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2463,12 +4053,44 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeployContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeployContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2490,7 +4112,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePermissions) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertAndTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2513,15 +4135,15 @@ func (m *MsgUpdatePermissions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePermissions: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertAndTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertAndTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2549,11 +4171,65 @@ func (m *MsgUpdatePermissions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Transfer = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2581,13 +4257,78 @@ func (m *MsgUpdatePermissions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
 			}
-			m.Permissions = 0
+			m.TimeoutTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2597,11 +4338,43 @@ func (m *MsgUpdatePermissions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Permissions |= uint64(b&0x7F) << shift
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2623,7 +4396,7 @@ func (m *MsgUpdatePermissions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePermissionsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertAndTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2646,10 +4419,10 @@ func (m *MsgUpdatePermissionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePermissionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertAndTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertAndTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5,7 +5,13 @@ import (
 	"math/big"
 	"strings"
 
+	"cosmossdk.io/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
 )
 
 var (
@@ -20,6 +26,14 @@ const (
 	gravityDenomLen    = len(gravityDenomPrefix) + 40
 	cronosDenomPrefix  = "cronos0x"
 	cronosDenomLen     = len(cronosDenomPrefix) + 40
+
+	// DefaultCRC21Decimals is the decimals of the auto-deployed contracts when the denom has no metadata
+	DefaultCRC21Decimals uint8 = 0
+	// MaxCRC21SymbolLength is the maximum length of the symbols derived from the denom metadata, it's the limit
+	// of the wallets like MetaMask
+	MaxCRC21SymbolLength = 11
+	// MaxContractsByDenomsQuery is the maximum number of denoms resolved by a single ContractsByDenoms query
+	MaxContractsByDenomsQuery = 100
 )
 
 // IsValidIBCDenom returns true if denom is a valid ibc denom
@@ -60,3 +74,110 @@ func GetContractAddressFromDenom(denom string) (string, error) {
 	}
 	return contractAddress, nil
 }
+
+// ParseContractAddress parses a hex contract address, a mixed-case address must have a valid EIP-55 checksum
+func ParseContractAddress(address string) (common.Address, error) {
+	if !common.IsHexAddress(address) {
+		return common.Address{}, fmt.Errorf("invalid contract address (%s)", address)
+	}
+	contract := common.HexToAddress(address)
+	digits := address
+	if has0xPrefix(digits) {
+		digits = digits[2:]
+	}
+	if digits != strings.ToLower(digits) && digits != strings.ToUpper(digits) && digits != contract.Hex()[2:] {
+		return common.Address{}, fmt.Errorf("invalid checksum of contract address (%s), expected %s", address, contract.Hex())
+	}
+	return contract, nil
+}
+
+func has0xPrefix(str string) bool {
+	return len(str) >= 2 && str[0] == '0' && (str[1] == 'x' || str[1] == 'X')
+}
+
+// ScaleAmount converts an amount expressed with `from` decimals to `to` decimals,
+// it fails if the result doesn't fit in an uint256 or if it would lose precision.
+func ScaleAmount(amount *big.Int, from, to uint8) (*big.Int, error) {
+	if amount.Sign() < 0 {
+		return nil, fmt.Errorf("negative amount %s", amount)
+	}
+	if from == to {
+		if amount.Cmp(math.MaxBig256) > 0 {
+			return nil, errors.Wrapf(ErrAmountOverflow, "amount %s exceeds the maximum uint256", amount)
+		}
+		return amount, nil
+	}
+	if from < to {
+		factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(to-from)), nil)
+		scaled := new(big.Int).Mul(amount, factor)
+		if scaled.Cmp(math.MaxBig256) > 0 {
+			return nil, errors.Wrapf(ErrAmountOverflow, "amount %s overflows when scaled from %d to %d decimals", amount, from, to)
+		}
+		return scaled, nil
+	}
+	factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(from-to)), nil)
+	scaled, remainder := new(big.Int).QuoRem(amount, factor, new(big.Int))
+	if remainder.Sign() != 0 {
+		return nil, errors.Wrapf(ErrAmountNotDivisible, "amount %s is not divisible by %s when scaled from %d to %d decimals", amount, factor, from, to)
+	}
+	return scaled, nil
+}
+
+// GetSourceChannelFromTrace returns the channel the voucher has been received from, the trace path
+// has for format port/channel[/port/channel...] where the first hop is the closest one to cronos
+func GetSourceChannelFromTrace(trace transfertypes.DenomTrace) (string, error) {
+	if trace.Path == "" {
+		return "", fmt.Errorf("denom trace of %s has an empty path", trace.BaseDenom)
+	}
+	parts := strings.Split(trace.Path, "/")
+	if len(parts)%2 != 0 {
+		return "", fmt.Errorf("invalid denom trace path %s", trace.Path)
+	}
+	for i := 0; i < len(parts); i += 2 {
+		if parts[i] == "" || !channeltypes.IsValidChannelID(parts[i+1]) {
+			return "", fmt.Errorf("invalid denom trace path %s", trace.Path)
+		}
+	}
+	return parts[1], nil
+}
+
+// GetCRC21NameFromTrace returns the name of the auto-deployed contract of an ibc voucher,
+// the full path is used so that the same base denom received through different paths
+// doesn't end up with the same name
+func GetCRC21NameFromTrace(trace transfertypes.DenomTrace) string {
+	return trace.GetFullDenomPath()
+}
+
+// GetCRC21NameAndSymbolFromMetadata returns the name and the symbol of the auto-deployed contract declared in the
+// bank metadata of the denom, the symbol falls back to the display unit and is sanitized to an upper case
+// alphanumeric string, both are empty when the metadata don't declare them.
+func GetCRC21NameAndSymbolFromMetadata(metadata banktypes.Metadata) (name, symbol string) {
+	symbol = SanitizeCRC21Symbol(metadata.Symbol)
+	if symbol == "" {
+		symbol = SanitizeCRC21Symbol(metadata.Display)
+	}
+	return strings.TrimSpace(metadata.Name), symbol
+}
+
+// SanitizeCRC21Symbol removes the characters which are not alphanumeric from the symbol and truncates it to
+// MaxCRC21SymbolLength, e.g. "u-atom" becomes "UATOM"
+func SanitizeCRC21Symbol(symbol string) string {
+	var b strings.Builder
+	for _, c := range strings.ToUpper(symbol) {
+		if b.Len() == MaxCRC21SymbolLength {
+			break
+		}
+		if (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+// IbcTransferOptions defines the optional parameters of the ibc transfers sent by the module
+type IbcTransferOptions struct {
+	// the timeouts are disabled if zero, the module default timeout is used if both of them are
+	TimeoutHeight    clienttypes.Height
+	TimeoutTimestamp uint64
+	Memo             string
+}
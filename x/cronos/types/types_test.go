@@ -1,8 +1,11 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -81,3 +84,139 @@ func Test_IsValidCoinDenom(t *testing.T) {
 		})
 	}
 }
+
+func Test_ScaleAmount(t *testing.T) {
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	// the largest amount of 6 decimals which fits in an uint256 once scaled to 18 decimals
+	maxDivisible := new(big.Int).Quo(maxUint256, big.NewInt(1e12))
+	tests := []struct {
+		name     string
+		amount   *big.Int
+		from     uint8
+		to       uint8
+		expected *big.Int
+		success  bool
+	}{
+		{"same decimals", big.NewInt(123), 6, 6, big.NewInt(123), true},
+		{"scale up", big.NewInt(123), 6, 18, new(big.Int).Mul(big.NewInt(123), big.NewInt(1e12)), true},
+		{"scale down", big.NewInt(123000000000000), 18, 6, big.NewInt(123), true},
+		{"scale down with remainder", big.NewInt(123000000000001), 18, 6, nil, false},
+		{"negative amount", big.NewInt(-1), 6, 18, nil, false},
+		{"max uint256 unscaled", maxUint256, 0, 0, maxUint256, true},
+		{"overflow", maxUint256, 0, 1, nil, false},
+		{"one unit over max uint256 unscaled", new(big.Int).Add(maxUint256, big.NewInt(1)), 6, 6, nil, false},
+		{"max uint256 scaled down", maxUint256, 18, 6, nil, false},
+		{"max divisible uint256 scaled down", new(big.Int).Mul(maxDivisible, big.NewInt(1e12)), 18, 6, maxDivisible, true},
+		{"max divisible scaled up", maxDivisible, 6, 18, new(big.Int).Mul(maxDivisible, big.NewInt(1e12)), true},
+		{"max uint256 scaled up overflow", new(big.Int).Add(maxDivisible, big.NewInt(1)), 6, 18, nil, false},
+		{"one unit below divisibility", big.NewInt(999999999999), 18, 6, nil, false},
+		{"smallest divisible amount", big.NewInt(1e12), 18, 6, big.NewInt(1), true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			scaled, err := ScaleAmount(tt.amount, tt.from, tt.to)
+			if !tt.success {
+				require.Error(t, err)
+				if tt.from > tt.to {
+					require.ErrorIs(t, err, ErrAmountNotDivisible)
+				} else if tt.amount.Sign() >= 0 {
+					require.ErrorIs(t, err, ErrAmountOverflow)
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 0, tt.expected.Cmp(scaled))
+		})
+	}
+}
+
+func Test_GetSourceChannelFromTrace(t *testing.T) {
+	tests := []struct {
+		name      string
+		trace     transfertypes.DenomTrace
+		channelID string
+		success   bool
+	}{
+		{"single hop", transfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}, "channel-0", true},
+		{"multi hop", transfertypes.DenomTrace{Path: "transfer/channel-1/transfer/channel-5", BaseDenom: "uatom"}, "channel-1", true},
+		{"empty path", transfertypes.DenomTrace{BaseDenom: "uatom"}, "", false},
+		{"odd path", transfertypes.DenomTrace{Path: "transfer/channel-1/transfer", BaseDenom: "uatom"}, "", false},
+		{"invalid channel", transfertypes.DenomTrace{Path: "transfer/chan", BaseDenom: "uatom"}, "", false},
+		{"empty port", transfertypes.DenomTrace{Path: "/channel-0", BaseDenom: "uatom"}, "", false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			channelID, err := GetSourceChannelFromTrace(tt.trace)
+			if tt.success {
+				require.NoError(t, err)
+				require.Equal(t, tt.channelID, channelID)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func Test_GetCRC21NameFromTrace(t *testing.T) {
+	singleHop := transfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}
+	multiHop := transfertypes.DenomTrace{Path: "transfer/channel-1/transfer/channel-5", BaseDenom: "uatom"}
+	require.Equal(t, "transfer/channel-0/uatom", GetCRC21NameFromTrace(singleHop))
+	require.Equal(t, "transfer/channel-1/transfer/channel-5/uatom", GetCRC21NameFromTrace(multiHop))
+	require.NotEqual(t, GetCRC21NameFromTrace(singleHop), GetCRC21NameFromTrace(multiHop))
+}
+
+func Test_ParseContractAddress(t *testing.T) {
+	tests := []struct {
+		name     string
+		address  string
+		expected string
+		success  bool
+	}{
+		{"empty", "", "", false},
+		{"too short", "0x57f96e6B86CdeFdB3d4125", "", false},
+		{"non hex", "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9DZ", "", false},
+		{"invalid checksum", "0x57F96e6B86CdeFdB3d412547816a82E3E0EbF9D2", "", false},
+		{"checksummed", "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2", "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2", true},
+		{"lowercase", "0x57f96e6b86cdefdb3d412547816a82e3e0ebf9d2", "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2", true},
+		{"uppercase", "0x57F96E6B86CDEFDB3D412547816A82E3E0EBF9D2", "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2", true},
+		{"no prefix", "57f96e6b86cdefdb3d412547816a82e3e0ebf9d2", "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2", true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			contract, err := ParseContractAddress(tt.address)
+			if tt.success {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, contract.Hex())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func Test_GetCRC21NameAndSymbolFromMetadata(t *testing.T) {
+	tests := []struct {
+		name           string
+		metadata       banktypes.Metadata
+		expectedName   string
+		expectedSymbol string
+	}{
+		{"empty metadata", banktypes.Metadata{}, "", ""},
+		{"display only", banktypes.Metadata{Display: "atom"}, "", "ATOM"},
+		{"name only", banktypes.Metadata{Name: " Cosmos Hub Atom "}, "Cosmos Hub Atom", ""},
+		{"symbol over display", banktypes.Metadata{Name: "Cosmos Hub Atom", Symbol: "ATOM", Display: "uatom"}, "Cosmos Hub Atom", "ATOM"},
+		{"invalid symbol falls back to display", banktypes.Metadata{Symbol: "$$", Display: "atom"}, "", "ATOM"},
+		{"sanitized symbol", banktypes.Metadata{Symbol: "u-atom.x/long_symbol"}, "", "UATOMXLONGS"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			name, symbol := GetCRC21NameAndSymbolFromMetadata(tt.metadata)
+			require.Equal(t, tt.expectedName, name)
+			require.Equal(t, tt.expectedSymbol, symbol)
+		})
+	}
+}